@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2024 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -8,7 +8,7 @@ package http
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"net/url"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
@@ -18,8 +18,6 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
-
-	"github.com/fxamacker/cbor/v2"
 )
 
 type deviceServiceCommandClient struct {
@@ -53,14 +51,9 @@ func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUr
 		return nil, nil
 	}
 	response := &responses.EventResponse{}
-	if contentType == common.ContentTypeCBOR {
-		if err = cbor.Unmarshal(res, response); err != nil {
-			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the cbor response", err)
-		}
-	} else {
-		if err = json.Unmarshal(res, response); err != nil {
-			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the json response", err)
-		}
+	codec := common.GetCodecOrDefault(contentType)
+	if err = codec.Unmarshal(res, response); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the %s response", codec.ContentType()), err)
 	}
 	return response, nil
 }
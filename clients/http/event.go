@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2025 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -25,6 +25,7 @@ type eventClient struct {
 	baseUrlFunc           clients.ClientBaseUrlFunc
 	authInjector          interfaces.AuthenticationInjector
 	enableNameFieldEscape bool
+	compression           utils.CompressionConfig
 }
 
 // NewEventClient creates an instance of EventClient
@@ -45,6 +46,29 @@ func NewEventClientWithUrlCallback(baseUrlFunc clients.ClientBaseUrlFunc, authIn
 	}
 }
 
+// NewEventClientWithCompression creates an instance of EventClient, which compresses the request body of Add
+// according to the CompressionConfig, e.g. for the events with large Binary readings, and limits the size of the
+// decompressed response bodies to the MaxDecompressedSize of the CompressionConfig.
+func NewEventClientWithCompression(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, compression utils.CompressionConfig) interfaces.EventClient {
+	return &eventClient{
+		baseUrlFunc:           clients.GetDefaultClientBaseUrlFunc(baseUrl),
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+		compression:           compression,
+	}
+}
+
+// NewEventClientWithUrlCallbackAndCompression creates an instance of EventClient with ClientBaseUrlFunc, which
+// compresses the request body of Add and limits the decompressed response bodies according to the CompressionConfig.
+func NewEventClientWithUrlCallbackAndCompression(baseUrlFunc clients.ClientBaseUrlFunc, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool, compression utils.CompressionConfig) interfaces.EventClient {
+	return &eventClient{
+		baseUrlFunc:           baseUrlFunc,
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+		compression:           compression,
+	}
+}
+
 func (ec *eventClient) Add(ctx context.Context, serviceName string, req requests.AddEventRequest) (
 	dtoCommon.BaseWithIdResponse, errors.EdgeX) {
 	requestPath := common.NewPathBuilder().EnableNameFieldEscape(ec.enableNameFieldEscape).
@@ -59,7 +83,7 @@ func (ec *eventClient) Add(ctx context.Context, serviceName string, req requests
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.PostRequest(ctx, &br, baseUrl, requestPath, bytes, encoding, ec.authInjector)
+	err = utils.PostRequestWithCompression(ec.compression.WithMaxDecompressedSize(ctx), &br, baseUrl, requestPath, bytes, encoding, ec.compression, ec.authInjector)
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -76,7 +100,7 @@ func (ec *eventClient) AllEventsWithQueryParams(ctx context.Context, offset, lim
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, common.ApiAllEventRoute, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
+	err = utils.GetRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, common.ApiAllEventRoute, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -89,7 +113,7 @@ func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse,
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, common.ApiEventCountRoute, nil, ec.authInjector)
+	err = utils.GetRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, common.ApiEventCountRoute, nil, ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -103,7 +127,7 @@ func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, nil, ec.authInjector)
+	err = utils.GetRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, nil, ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -122,7 +146,7 @@ func (ec *eventClient) EventsByDeviceNameWithQueryParams(ctx context.Context, na
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
+	err = utils.GetRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -136,7 +160,7 @@ func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dto
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.DeleteRequest(ctx, &res, baseUrl, requestPath, ec.authInjector)
+	err = utils.DeleteRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -155,7 +179,7 @@ func (ec *eventClient) EventsByTimeRangeWithQueryParams(ctx context.Context, sta
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
+	err = utils.GetRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, utils.ToRequestParameters(offset, limit, queryParams), ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -169,7 +193,7 @@ func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.Base
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.DeleteRequest(ctx, &res, baseUrl, requestPath, ec.authInjector)
+	err = utils.DeleteRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -183,7 +207,7 @@ func (ec *eventClient) DeleteById(ctx context.Context, id string) (dtoCommon.Bas
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-	err = utils.DeleteRequest(ctx, &res, baseUrl, requestPath, ec.authInjector)
+	err = utils.DeleteRequest(ec.compression.WithMaxDecompressedSize(ctx), &res, baseUrl, requestPath, ec.authInjector)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -9,15 +9,18 @@ package http
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"strconv"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,6 +41,43 @@ func TestAddEvent(t *testing.T) {
 	assert.IsType(t, dtoCommon.BaseWithIdResponse{}, res)
 }
 
+func TestAddEventWithCompression(t *testing.T) {
+	serviceName := "serviceName"
+	event := dtos.NewEvent("profileName", "deviceName", "sourceName")
+	event.AddBinaryReading("resourceName", make([]byte, 2048), "application/octet-stream")
+	apiRoute := path.Join(common.ApiEventRoute, serviceName, event.ProfileName, event.DeviceName, event.SourceName)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != apiRoute || r.Header.Get(common.ContentEncoding) != common.ContentEncodingGzip {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	compression := utils.CompressionConfig{ContentEncoding: common.ContentEncodingGzip, Threshold: 1024}
+	client := NewEventClientWithCompression(ts.URL, NewNullAuthenticationInjector(), false, compression)
+	res, err := client.Add(context.Background(), serviceName, requests.NewAddEventRequest(event))
+	require.NoError(t, err)
+	assert.IsType(t, dtoCommon.BaseWithIdResponse{}, res)
+}
+
+func TestQueryAllEventsWithMaxDecompressedSize(t *testing.T) {
+	compressed, edgexErr := utils.CompressData(make([]byte, 2048), common.ContentEncodingZstd)
+	require.NoError(t, edgexErr)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(common.ContentEncoding, common.ContentEncodingZstd)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compressed)
+	}))
+	defer ts.Close()
+
+	client := NewEventClientWithCompression(ts.URL, NewNullAuthenticationInjector(), false, utils.CompressionConfig{MaxDecompressedSize: 1024})
+	_, err := client.AllEvents(context.Background(), 1, 10)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
+
 func TestQueryAllEvents(t *testing.T) {
 	ts := newTestServer(http.MethodGet, common.ApiAllEventRoute, responses.MultiEventsResponse{})
 	defer ts.Close()
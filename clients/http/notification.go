@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2025 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -222,8 +222,13 @@ func (client *NotificationClient) DeleteProcessedNotificationsByAge(ctx context.
 	return res, nil
 }
 
-// NotificationsByQueryConditions queries notifications with offset, limit, acknowledgement status, category and time range
+// NotificationsByQueryConditions queries notifications with offset, limit, acknowledgement status and the query
+// condition, which filters by category, time range, severity, labels, sender, status and acknowledgement.
+// An error is returned if the ack and the Acknowledged filter of the query condition are both specified but conflict.
 func (client *NotificationClient) NotificationsByQueryConditions(ctx context.Context, offset, limit int, ack string, conditionReq requests.GetNotificationRequest) (res responses.MultiNotificationsResponse, err errors.EdgeX) {
+	if err = conditionReq.ValidateAck(ack); err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
@@ -270,3 +275,19 @@ func (client *NotificationClient) UpdateNotificationAckStatusByIds(ctx context.C
 	}
 	return res, nil
 }
+
+// AcknowledgeNotificationByToken acknowledges the notification authorized by the ack token, which is usually carried
+// by the link in the notification content. The link should point to a landing page calling this method rather than
+// to the API, which only accepts PUT.
+func (client *NotificationClient) AcknowledgeNotificationByToken(ctx context.Context, token string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath := utils.EscapeAndJoinPath(common.ApiNotificationRoute, common.Acknowledge, common.Token, token)
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.PutRequest(ctx, &res, baseUrl, requestPath, nil, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2024 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -162,6 +162,11 @@ func TestNotificationClient_NotificationsByQueryConditions(t *testing.T) {
 	res, err := client.NotificationsByQueryConditions(context.Background(), 0, 10, "", requests.GetNotificationRequest{})
 	require.NoError(t, err)
 	require.IsType(t, responses.MultiNotificationsResponse{}, res)
+
+	acknowledged := false
+	conditionReq := requests.GetNotificationRequest{QueryCondition: requests.NotificationQueryCondition{Acknowledged: &acknowledged}}
+	_, err = client.NotificationsByQueryConditions(context.Background(), 0, 10, common.ValueTrue, conditionReq)
+	require.Error(t, err)
 }
 
 func TestNotificationClient_DeleteNotificationByIds(t *testing.T) {
@@ -185,3 +190,14 @@ func TestNotificationClient_UpdateNotificationAckStatusByIds(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestNotificationClient_AcknowledgeNotificationByToken(t *testing.T) {
+	token := "eyJuIjoiaWQifQ.c2lnbmF0dXJl"
+	path := utils.EscapeAndJoinPath(common.ApiNotificationRoute, common.Acknowledge, common.Token, token)
+	ts := newTestServer(http.MethodPut, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewNotificationClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.AcknowledgeNotificationByToken(context.Background(), token)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+type NotificationTemplateClient struct {
+	baseUrlFunc           clients.ClientBaseUrlFunc
+	authInjector          interfaces.AuthenticationInjector
+	enableNameFieldEscape bool
+}
+
+// NewNotificationTemplateClient creates an instance of NotificationTemplateClient
+func NewNotificationTemplateClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.NotificationTemplateClient {
+	return &NotificationTemplateClient{
+		baseUrlFunc:           clients.GetDefaultClientBaseUrlFunc(baseUrl),
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+	}
+}
+
+// NewNotificationTemplateClientWithUrlCallback creates an instance of NotificationTemplateClient with ClientBaseUrlFunc.
+func NewNotificationTemplateClientWithUrlCallback(baseUrlFunc clients.ClientBaseUrlFunc, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) interfaces.NotificationTemplateClient {
+	return &NotificationTemplateClient{
+		baseUrlFunc:           baseUrlFunc,
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+	}
+}
+
+// Add adds new notification templates.
+func (client *NotificationTemplateClient) Add(ctx context.Context, reqs []requests.AddNotificationTemplateRequest) (res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.PostRequestWithRawData(ctx, &res, baseUrl, common.ApiNotificationTemplateRoute, nil, reqs, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// Update updates notification templates.
+func (client *NotificationTemplateClient) Update(ctx context.Context, reqs []requests.UpdateNotificationTemplateRequest) (res []dtoCommon.BaseResponse, err errors.EdgeX) {
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.PatchRequest(ctx, &res, baseUrl, common.ApiNotificationTemplateRoute, nil, reqs, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllNotificationTemplates queries notification templates with offset and limit
+func (client *NotificationTemplateClient) AllNotificationTemplates(ctx context.Context, offset int, limit int) (res responses.MultiNotificationTemplatesResponse, err errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, common.ApiAllNotificationTemplateRoute, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// NotificationTemplateByName queries notification template by name.
+func (client *NotificationTemplateClient) NotificationTemplateByName(ctx context.Context, name string) (res responses.NotificationTemplateResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiNotificationTemplateRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// DeleteNotificationTemplateByName deletes a notification template by name.
+func (client *NotificationTemplateClient) DeleteNotificationTemplateByName(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiNotificationTemplateRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.DeleteRequest(ctx, &res, baseUrl, requestPath, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
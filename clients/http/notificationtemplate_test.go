@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testNotificationTemplateName = "device-alert"
+
+func TestNotificationTemplateClient_Add(t *testing.T) {
+	ts := newTestServer(http.MethodPost, common.ApiNotificationTemplateRoute, []dtoCommon.BaseWithIdResponse{})
+	defer ts.Close()
+	client := NewNotificationTemplateClient(ts.URL, NewNullAuthenticationInjector(), false)
+	req := requests.NewAddNotificationTemplateRequest(dtos.NotificationTemplate{Name: testNotificationTemplateName, Content: "{{ .Content }}"})
+	res, err := client.Add(context.Background(), []requests.AddNotificationTemplateRequest{req})
+	require.NoError(t, err)
+	require.IsType(t, []dtoCommon.BaseWithIdResponse{}, res)
+}
+
+func TestNotificationTemplateClient_Update(t *testing.T) {
+	ts := newTestServer(http.MethodPatch, common.ApiNotificationTemplateRoute, []dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewNotificationTemplateClient(ts.URL, NewNullAuthenticationInjector(), false)
+	name := testNotificationTemplateName
+	content := "[{{ .Severity }}] {{ .Content }}"
+	req := requests.NewUpdateNotificationTemplateRequest(dtos.UpdateNotificationTemplate{Name: &name, Content: &content})
+	res, err := client.Update(context.Background(), []requests.UpdateNotificationTemplateRequest{req})
+	require.NoError(t, err)
+	require.IsType(t, []dtoCommon.BaseResponse{}, res)
+}
+
+func TestNotificationTemplateClient_AllNotificationTemplates(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllNotificationTemplateRoute, responses.MultiNotificationTemplatesResponse{})
+	defer ts.Close()
+	client := NewNotificationTemplateClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.AllNotificationTemplates(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.IsType(t, responses.MultiNotificationTemplatesResponse{}, res)
+}
+
+func TestNotificationTemplateClient_NotificationTemplateByName(t *testing.T) {
+	path := path.Join(common.ApiNotificationTemplateRoute, common.Name, testNotificationTemplateName)
+	ts := newTestServer(http.MethodGet, path, responses.NotificationTemplateResponse{})
+	defer ts.Close()
+	client := NewNotificationTemplateClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.NotificationTemplateByName(context.Background(), testNotificationTemplateName)
+	require.NoError(t, err)
+	require.IsType(t, responses.NotificationTemplateResponse{}, res)
+}
+
+func TestNotificationTemplateClient_DeleteNotificationTemplateByName(t *testing.T) {
+	path := path.Join(common.ApiNotificationTemplateRoute, common.Name, testNotificationTemplateName)
+	ts := newTestServer(http.MethodDelete, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewNotificationTemplateClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.DeleteNotificationTemplateByName(context.Background(), testNotificationTemplateName)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
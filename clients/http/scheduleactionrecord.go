@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -15,6 +15,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -133,3 +134,84 @@ func (client *ScheduleActionRecordClient) ScheduleActionRecordsByJobNameAndStatu
 	}
 	return res, nil
 }
+
+// ScheduleActionRecordsByActionType queries schedule action records with actionType, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+func (client *ScheduleActionRecordClient) ScheduleActionRecordsByActionType(ctx context.Context, actionType, status string, start, end int64, offset, limit int) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
+	requestPath := path.Join(common.ApiScheduleActionRecordRoute, common.Action, common.Type, actionType)
+	requestParams := url.Values{}
+	if status != "" {
+		requestParams.Set(common.Status, status)
+	}
+	requestParams.Set(common.Start, strconv.FormatInt(start, 10))
+	requestParams.Set(common.End, strconv.FormatInt(end, 10))
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// ScheduleActionRecordsByDeviceName queries the DEVICECONTROL schedule action records with deviceName, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+func (client *ScheduleActionRecordClient) ScheduleActionRecordsByDeviceName(ctx context.Context, deviceName, status string, start, end int64, offset, limit int) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiScheduleActionRecordRoute).SetPath(common.Device).SetPath(common.Name).SetNameFieldPath(deviceName).BuildPath()
+	requestParams := url.Values{}
+	if status != "" {
+		requestParams.Set(common.Status, status)
+	}
+	requestParams.Set(common.Start, strconv.FormatInt(start, 10))
+	requestParams.Set(common.End, strconv.FormatInt(end, 10))
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// ScheduleActionRecordsByAddress queries the REST schedule action records with address, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+func (client *ScheduleActionRecordClient) ScheduleActionRecordsByAddress(ctx context.Context, address, status string, start, end int64, offset, limit int) (res responses.MultiScheduleActionRecordsResponse, err errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Address, address)
+	if status != "" {
+		requestParams.Set(common.Status, status)
+	}
+	requestParams.Set(common.Start, strconv.FormatInt(start, 10))
+	requestParams.Set(common.End, strconv.FormatInt(end, 10))
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, common.ApiScheduleActionRecordRouteByAddressRoute, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// DeleteScheduleActionRecordsByAge deletes the schedule action records that are older than age in milliseconds
+func (client *ScheduleActionRecordClient) DeleteScheduleActionRecordsByAge(ctx context.Context, age int) (res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath := path.Join(common.ApiScheduleActionRecordRoute, common.Age, strconv.Itoa(age))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.DeleteRequest(ctx, &res, baseUrl, requestPath, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
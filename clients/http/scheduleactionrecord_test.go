@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,13 +7,16 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
@@ -71,3 +74,63 @@ func TestScheduleActionRecordClient_ScheduleActionRecordsByJobNameAndStatus(t *t
 	require.NoError(t, err)
 	require.IsType(t, responses.MultiScheduleActionRecordsResponse{}, res)
 }
+
+func newScheduleActionRecordTestServer(apiRoute, status string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodGet || r.URL.EscapedPath() != apiRoute || query.Get(common.Status) != status || query.Has(common.Status) != (status != "") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		b, _ := json.Marshal(responses.MultiScheduleActionRecordsResponse{})
+		_, _ = w.Write(b)
+	}))
+}
+
+func TestScheduleActionRecordClient_ScheduleActionRecordsByActionType(t *testing.T) {
+	actionType := common.ActionDeviceControl
+	urlPath := path.Join(common.ApiScheduleActionRecordRoute, common.Action, common.Type, actionType)
+	for _, status := range []string{"", models.Failed} {
+		ts := newScheduleActionRecordTestServer(urlPath, status)
+		client := NewScheduleActionRecordClient(ts.URL, NewNullAuthenticationInjector(), false)
+		res, err := client.ScheduleActionRecordsByActionType(context.Background(), actionType, status, 0, 0, 0, 10)
+		ts.Close()
+		require.NoError(t, err)
+		require.IsType(t, responses.MultiScheduleActionRecordsResponse{}, res)
+	}
+}
+
+func TestScheduleActionRecordClient_ScheduleActionRecordsByDeviceName(t *testing.T) {
+	deviceName := TestDeviceName
+	urlPath := path.Join(common.ApiScheduleActionRecordRoute, common.Device, common.Name, deviceName)
+	for _, status := range []string{"", models.Failed} {
+		ts := newScheduleActionRecordTestServer(urlPath, status)
+		client := NewScheduleActionRecordClient(ts.URL, NewNullAuthenticationInjector(), false)
+		res, err := client.ScheduleActionRecordsByDeviceName(context.Background(), deviceName, status, 0, 0, 0, 10)
+		ts.Close()
+		require.NoError(t, err)
+		require.IsType(t, responses.MultiScheduleActionRecordsResponse{}, res)
+	}
+}
+
+func TestScheduleActionRecordClient_ScheduleActionRecordsByAddress(t *testing.T) {
+	for _, status := range []string{"", models.Failed} {
+		ts := newScheduleActionRecordTestServer(common.ApiScheduleActionRecordRouteByAddressRoute, status)
+		client := NewScheduleActionRecordClient(ts.URL, NewNullAuthenticationInjector(), false)
+		res, err := client.ScheduleActionRecordsByAddress(context.Background(), "http://localhost:59881/api/v3/ping", status, 0, 0, 0, 10)
+		ts.Close()
+		require.NoError(t, err)
+		require.IsType(t, responses.MultiScheduleActionRecordsResponse{}, res)
+	}
+}
+
+func TestScheduleActionRecordClient_DeleteScheduleActionRecordsByAge(t *testing.T) {
+	urlPath := path.Join(common.ApiScheduleActionRecordRoute, common.Age, "86400000")
+	ts := newTestServer(http.MethodDelete, urlPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewScheduleActionRecordClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.DeleteScheduleActionRecordsByAge(context.Background(), 86400000)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -140,3 +140,79 @@ func (client ScheduleJobClient) TriggerScheduleJobByName(ctx context.Context, na
 	}
 	return res, nil
 }
+
+// LockScheduleJobByName locks the schedule job by name
+func (client ScheduleJobClient) LockScheduleJobByName(ctx context.Context, name string) (
+	res dtoCommon.BaseResponse, err errors.EdgeX) {
+	return client.setScheduleJobAdminState(ctx, common.Lock, name)
+}
+
+// UnlockScheduleJobByName unlocks the schedule job by name
+func (client ScheduleJobClient) UnlockScheduleJobByName(ctx context.Context, name string) (
+	res dtoCommon.BaseResponse, err errors.EdgeX) {
+	return client.setScheduleJobAdminState(ctx, common.Unlock, name)
+}
+
+func (client ScheduleJobClient) setScheduleJobAdminState(ctx context.Context, operation, name string) (
+	res dtoCommon.BaseResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiScheduleJobRoute).SetPath(operation).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.PutRequest(ctx, &res, baseUrl, requestPath, nil, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// ScheduleJobStatisticsByName queries the statistics of the schedule job by name with start and end
+func (client ScheduleJobClient) ScheduleJobStatisticsByName(ctx context.Context, name string, start, end int64) (
+	res responses.ScheduleJobStatisticsResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiScheduleJobStatisticsRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	requestParams := url.Values{}
+	requestParams.Set(common.Start, strconv.FormatInt(start, 10))
+	requestParams.Set(common.End, strconv.FormatInt(end, 10))
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllScheduleJobDependencies queries the dependency graph of all schedule jobs
+func (client ScheduleJobClient) AllScheduleJobDependencies(ctx context.Context) (
+	res responses.ScheduleJobDependencyGraphResponse, err errors.EdgeX) {
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, common.ApiAllScheduleJobDependencyRoute, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// ScheduleJobDependenciesByName queries the dependency graph of the schedule job by name
+func (client ScheduleJobClient) ScheduleJobDependenciesByName(ctx context.Context, name string) (
+	res responses.ScheduleJobDependencyGraphResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiScheduleJobDependencyRoute).SetPath(common.Name).SetNameFieldPath(name).BuildPath()
+	baseUrl, goErr := clients.GetBaseUrl(client.baseUrlFunc)
+	if goErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(goErr)
+	}
+	err = utils.GetRequest(ctx, &res, baseUrl, requestPath, nil, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -129,3 +129,56 @@ func TestScheduleJobClient_TriggerScheduleJobByName(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestScheduleJobClient_LockScheduleJobByName(t *testing.T) {
+	scheduleJobName := TestScheduleJobName
+	requestPath := path.Join(common.ApiScheduleJobRoute, common.Lock, common.Name, scheduleJobName)
+	ts := newTestServer(http.MethodPut, requestPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewScheduleJobClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.LockScheduleJobByName(context.Background(), scheduleJobName)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
+func TestScheduleJobClient_UnlockScheduleJobByName(t *testing.T) {
+	scheduleJobName := TestScheduleJobName
+	requestPath := path.Join(common.ApiScheduleJobRoute, common.Unlock, common.Name, scheduleJobName)
+	ts := newTestServer(http.MethodPut, requestPath, dtoCommon.BaseResponse{})
+	defer ts.Close()
+	client := NewScheduleJobClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.UnlockScheduleJobByName(context.Background(), scheduleJobName)
+	require.NoError(t, err)
+	require.IsType(t, dtoCommon.BaseResponse{}, res)
+}
+
+func TestScheduleJobClient_ScheduleJobStatisticsByName(t *testing.T) {
+	scheduleJobName := TestScheduleJobName
+	requestPath := path.Join(common.ApiScheduleJobStatisticsRoute, common.Name, scheduleJobName)
+	ts := newTestServer(http.MethodGet, requestPath, responses.ScheduleJobStatisticsResponse{})
+	defer ts.Close()
+	client := NewScheduleJobClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.ScheduleJobStatisticsByName(context.Background(), scheduleJobName, 0, 10)
+	require.NoError(t, err)
+	require.IsType(t, responses.ScheduleJobStatisticsResponse{}, res)
+}
+
+func TestScheduleJobClient_AllScheduleJobDependencies(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllScheduleJobDependencyRoute, responses.ScheduleJobDependencyGraphResponse{})
+	defer ts.Close()
+	client := NewScheduleJobClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.AllScheduleJobDependencies(context.Background())
+	require.NoError(t, err)
+	require.IsType(t, responses.ScheduleJobDependencyGraphResponse{}, res)
+}
+
+func TestScheduleJobClient_ScheduleJobDependenciesByName(t *testing.T) {
+	scheduleJobName := TestScheduleJobName
+	requestPath := path.Join(common.ApiScheduleJobDependencyRoute, common.Name, scheduleJobName)
+	ts := newTestServer(http.MethodGet, requestPath, responses.ScheduleJobDependencyGraphResponse{})
+	defer ts.Close()
+	client := NewScheduleJobClient(ts.URL, NewNullAuthenticationInjector(), false)
+	res, err := client.ScheduleJobDependenciesByName(context.Background(), scheduleJobName)
+	require.NoError(t, err)
+	require.IsType(t, responses.ScheduleJobDependencyGraphResponse{}, res)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -118,3 +118,31 @@ func (client *TransmissionClient) TransmissionsByNotificationId(ctx context.Cont
 	}
 	return res, nil
 }
+
+// TransmissionsByChannelType query transmissions with channel type, offset and limit
+func (client *TransmissionClient) TransmissionsByChannelType(ctx context.Context, channelType string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiTransmissionRoute).SetPath(common.Channel).SetPath(common.Type).SetNameFieldPath(channelType).BuildPath()
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// TransmissionsByChannelHost query transmissions with the host of the channel, offset and limit
+func (client *TransmissionClient) TransmissionsByChannelHost(ctx context.Context, host string, offset int, limit int) (res responses.MultiTransmissionsResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(client.enableNameFieldEscape).
+		SetPath(common.ApiTransmissionRoute).SetPath(common.Channel).SetPath(common.Host).SetNameFieldPath(host).BuildPath()
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams, client.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -96,3 +96,38 @@ func TestTransmissionClient_TransmissionsByNotificationId(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, responses.MultiTransmissionsResponse{}, res)
 }
+
+func TestTransmissionClient_TransmissionsByChannelType(t *testing.T) {
+	for _, enableNameFieldEscape := range []bool{false, true} {
+		urlPath := common.NewPathBuilder().EnableNameFieldEscape(enableNameFieldEscape).
+			SetPath(common.ApiTransmissionRoute).SetPath(common.Channel).SetPath(common.Type).SetNameFieldPath(common.EMAIL).BuildPath()
+		ts := newTestServer(http.MethodGet, urlPath, responses.MultiTransmissionsResponse{})
+		client := NewTransmissionClient(ts.URL, NewNullAuthenticationInjector(), enableNameFieldEscape)
+		res, err := client.TransmissionsByChannelType(context.Background(), common.EMAIL, 0, 10)
+		ts.Close()
+		require.NoError(t, err)
+		require.IsType(t, responses.MultiTransmissionsResponse{}, res)
+	}
+}
+
+func TestTransmissionClient_TransmissionsByChannelHost(t *testing.T) {
+	host := "hooks.example.com"
+	tests := []struct {
+		name                  string
+		enableNameFieldEscape bool
+		expectedPath          string
+	}{
+		{"escape disabled", false, common.ApiTransmissionRoute + "/channel/host/hooks.example.com"},
+		{"escape enabled", true, common.ApiTransmissionRoute + "/channel/host/hooks%2Eexample%2Ecom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(http.MethodGet, tt.expectedPath, responses.MultiTransmissionsResponse{})
+			defer ts.Close()
+			client := NewTransmissionClient(ts.URL, NewNullAuthenticationInjector(), tt.enableNameFieldEscape)
+			res, err := client.TransmissionsByChannelHost(context.Background(), host, 0, 10)
+			require.NoError(t, err)
+			require.IsType(t, responses.MultiTransmissionsResponse{}, res)
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -9,7 +9,6 @@ package utils
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -48,12 +47,22 @@ func correlatedId(ctx context.Context) string {
 	return correlation
 }
 
-// Helper method to get the body from the response after making the request
+// Helper method to get the body from the response after making the request.
+// The body is decompressed according to the Content-Encoding header of the response, up to the maximum size
+// specified by WithMaxDecompressedSize in the request context.
 func getBody(resp *http.Response) ([]byte, errors.EdgeX) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return body, errors.NewCommonEdgeX(errors.KindIOError, "failed to get the body from the response", err)
 	}
+	maxSize := int64(DefaultMaxDecompressedSize)
+	if resp.Request != nil {
+		maxSize = maxDecompressedSizeFromContext(resp.Request.Context())
+	}
+	body, edgexErr := DecompressDataWithLimit(body, resp.Header.Get(common.ContentEncoding), maxSize)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	return body, nil
 }
 
@@ -90,7 +99,8 @@ func CreateRequest(ctx context.Context, httpMethod string, baseUrl string, reque
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
-	return req, nil
+	setAcceptHeaders(ctx, req)
+	return withMaxDecompressedSize(ctx, req), nil
 }
 
 func CreateRequestWithRawDataAndParams(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}) (*http.Request, errors.EdgeX) {
@@ -101,23 +111,24 @@ func CreateRequestWithRawDataAndParams(ctx context.Context, httpMethod string, b
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
-	jsonEncodedData, err := json.Marshal(data)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode input data to JSON", err)
-	}
-
 	content := FromContext(ctx, common.ContentType)
 	if content == "" {
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
+	encodedData, edgexErr := encodeData(content, data)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(encodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
-	return req, nil
+	setAcceptHeaders(ctx, req)
+	return withMaxDecompressedSize(ctx, req), nil
 }
 
 func CreateRequestWithRawData(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}) (*http.Request, errors.EdgeX) {
@@ -129,23 +140,24 @@ func CreateRequestWithRawData(ctx context.Context, httpMethod string, baseUrl st
 		u.RawQuery = requestParams.Encode()
 	}
 
-	jsonEncodedData, err := json.Marshal(data)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode input data to JSON", err)
-	}
-
 	content := FromContext(ctx, common.ContentType)
 	if content == "" {
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
+	encodedData, edgexErr := encodeData(content, data)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+
+	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(encodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
-	return req, nil
+	setAcceptHeaders(ctx, req)
+	return withMaxDecompressedSize(ctx, req), nil
 }
 
 func CreateRequestWithRawDataAndHeaders(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data any, headers map[string]string) (*http.Request, errors.EdgeX) {
@@ -179,7 +191,8 @@ func CreateRequestWithEncodedData(ctx context.Context, httpMethod string, baseUr
 	}
 	req.Header.Set(common.ContentType, content)
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
-	return req, nil
+	setAcceptHeaders(ctx, req)
+	return withMaxDecompressedSize(ctx, req), nil
 }
 
 // CreateRequestFromFilePath creates multipart/form-data request with the specified file
@@ -215,15 +228,56 @@ func CreateRequestFromFilePath(ctx context.Context, httpMethod string, baseUrl s
 	}
 	req.Header.Set(common.ContentType, writer.FormDataContentType())
 	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
-	return req, nil
+	setAcceptHeaders(ctx, req)
+	return withMaxDecompressedSize(ctx, req), nil
+}
+
+// encodeData encodes the data with the Codec registered for the content type. The data is encoded to JSON
+// if there is no Codec registered for the content type.
+func encodeData(contentType string, data any) ([]byte, errors.EdgeX) {
+	codec := common.GetCodecOrDefault(contentType)
+	encodedData, err := codec.Marshal(data)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode input data to %s", codec.ContentType()), err)
+	}
+	return encodedData, nil
+}
+
+// setAcceptHeaders sets the Accept and Accept-Encoding headers from the supplied context, so that the caller can
+// negotiate the content type and the compression of the response, e.g. application/cbor with zstd for a large
+// amount of readings
+func setAcceptHeaders(ctx context.Context, req *http.Request) {
+	if accept := FromContext(ctx, common.Accept); accept != "" {
+		req.Header.Set(common.Accept, accept)
+	}
+	if acceptEncoding := FromContext(ctx, common.AcceptEncoding); acceptEncoding != "" {
+		req.Header.Set(common.AcceptEncoding, acceptEncoding)
+	}
+}
+
+// withMaxDecompressedSize returns a shallow copy of the request carrying the maximum decompressed size specified
+// by WithMaxDecompressedSize in the supplied context, which limits the decompressed response body. Only the value
+// is carried so that the cancellation of the request is not changed.
+func withMaxDecompressedSize(ctx context.Context, req *http.Request) *http.Request {
+	size, ok := ctx.Value(maxDecompressedSizeKey{}).(int64)
+	if !ok {
+		return req
+	}
+	return req.WithContext(WithMaxDecompressedSize(req.Context(), size))
 }
 
 // SendRequest will make a request with raw data to the specified URL.
 // It returns the body as a byte array if successful and an error otherwise.
 func SendRequest(ctx context.Context, req *http.Request, authInjector interfaces.AuthenticationInjector) ([]byte, errors.EdgeX) {
+	bodyBytes, _, err := sendRequest(req, authInjector)
+	return bodyBytes, err
+}
+
+// sendRequest makes the request and returns the response body along with the response content type
+func sendRequest(req *http.Request, authInjector interfaces.AuthenticationInjector) ([]byte, string, errors.EdgeX) {
 	resp, err := makeRequest(req, authInjector)
 	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+		return nil, "", errors.NewCommonEdgeXWrapper(err)
 	}
 	defer func(Body io.ReadCloser) {
 		_ = Body.Close()
@@ -231,18 +285,19 @@ func SendRequest(ctx context.Context, req *http.Request, authInjector interfaces
 
 	bodyBytes, err := getBody(resp)
 	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+		return nil, "", errors.NewCommonEdgeXWrapper(err)
 	}
 
+	contentType := resp.Header.Get(common.ContentType)
 	if resp.StatusCode <= http.StatusMultiStatus {
-		return bodyBytes, nil
+		return bodyBytes, contentType, nil
 	}
 
 	var errMsg string
 	var errResp dtosCommon.BaseResponse
 	// If the bodyBytes can be unmarshalled to BaseResponse DTO, use the BaseResponse.Message field as the error message
 	// Otherwise, use the whole bodyBytes string as the error message
-	baseRespErr := json.Unmarshal(bodyBytes, &errResp)
+	baseRespErr := common.GetCodecOrDefault(contentType).Unmarshal(bodyBytes, &errResp)
 	if baseRespErr == nil {
 		errMsg = errResp.Message
 	} else {
@@ -252,7 +307,7 @@ func SendRequest(ctx context.Context, req *http.Request, authInjector interfaces
 	// Handle error response
 	msg := fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, errMsg)
 	errKind := errors.KindMapping(resp.StatusCode)
-	return bodyBytes, errors.NewCommonEdgeX(errKind, msg, nil)
+	return bodyBytes, contentType, errors.NewCommonEdgeX(errKind, msg, nil)
 }
 
 // EscapeAndJoinPath escape and join the path variables
@@ -0,0 +1,166 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	goErrors "errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DefaultCompressionThreshold is the request body size in bytes from which the body is compressed,
+// used when the CompressionConfig doesn't specify a threshold
+const DefaultCompressionThreshold = 64 * 1024
+
+// DefaultMaxDecompressedSize is the maximum size in bytes of the decompressed data, used unless another maximum
+// is specified by the CompressionConfig or WithMaxDecompressedSize
+const DefaultMaxDecompressedSize = 64 * 1024 * 1024
+
+// zstdDefaultWindowSize is the window size of the zstd encoder with the default compression level
+const zstdDefaultWindowSize = 8 * 1024 * 1024
+
+type maxDecompressedSizeKey struct{}
+
+// WithMaxDecompressedSize returns a copy of the context with the maximum size in bytes of the decompressed response
+// bodies of the requests made with the context, to guard against the decompression bombs. A size not greater than
+// zero means DefaultMaxDecompressedSize.
+func WithMaxDecompressedSize(ctx context.Context, size int64) context.Context {
+	return context.WithValue(ctx, maxDecompressedSizeKey{}, size)
+}
+
+// maxDecompressedSizeFromContext returns the maximum size in bytes of the decompressed response bodies specified
+// by WithMaxDecompressedSize, or DefaultMaxDecompressedSize if none is specified
+func maxDecompressedSizeFromContext(ctx context.Context) int64 {
+	if size, ok := ctx.Value(maxDecompressedSizeKey{}).(int64); ok && size > 0 {
+		return size
+	}
+	return DefaultMaxDecompressedSize
+}
+
+// CompressionConfig defines the opt-in compression of the request body
+type CompressionConfig struct {
+	// ContentEncoding is the content coding used to compress the request body, i.e. gzip or zstd.
+	// The request body is not compressed if the ContentEncoding is empty.
+	ContentEncoding string
+	// Threshold is the minimum request body size in bytes to compress, so that small bodies are not
+	// compressed for nothing. DefaultCompressionThreshold is used if the Threshold is zero.
+	Threshold int
+	// MaxDecompressedSize is the maximum size in bytes of the decompressed response bodies, unless another maximum
+	// is specified for the request by WithMaxDecompressedSize. DefaultMaxDecompressedSize is used if the
+	// MaxDecompressedSize is zero.
+	MaxDecompressedSize int64
+}
+
+// WithMaxDecompressedSize returns a copy of the context with the MaxDecompressedSize of the CompressionConfig,
+// unless the context already specifies a maximum size
+func (c CompressionConfig) WithMaxDecompressedSize(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(maxDecompressedSizeKey{}).(int64); ok || c.MaxDecompressedSize <= 0 {
+		return ctx
+	}
+	return WithMaxDecompressedSize(ctx, c.MaxDecompressedSize)
+}
+
+// shouldCompress checks whether the data should be compressed according to the CompressionConfig
+func (c CompressionConfig) shouldCompress(data []byte) bool {
+	if c.ContentEncoding == "" {
+		return false
+	}
+	threshold := c.Threshold
+	if threshold <= 0 {
+		threshold = DefaultCompressionThreshold
+	}
+	return len(data) >= threshold
+}
+
+// CompressData compresses the data with the specified content coding, i.e. gzip or zstd
+func CompressData(data []byte, contentEncoding string) ([]byte, errors.EdgeX) {
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch normalizeContentEncoding(contentEncoding) {
+	case common.ContentEncodingGzip:
+		writer = gzip.NewWriter(&buf)
+	case common.ContentEncodingZstd:
+		zstdWriter, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create the zstd writer", err)
+		}
+		writer = zstdWriter
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content encoding '%s'", contentEncoding), nil)
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		_ = writer.Close()
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to compress data with %s", contentEncoding), err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to compress data with %s", contentEncoding), err)
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressData decompresses the data with the specified content coding. The data is returned as it is
+// if the content coding is empty or identity. An error of the LimitExceeded kind is returned if the
+// decompressed data exceeds DefaultMaxDecompressedSize.
+func DecompressData(data []byte, contentEncoding string) ([]byte, errors.EdgeX) {
+	return DecompressDataWithLimit(data, contentEncoding, DefaultMaxDecompressedSize)
+}
+
+// DecompressDataWithLimit decompresses the data like DecompressData, but returns an error of the LimitExceeded
+// kind if the decompressed data exceeds maxSize bytes
+func DecompressDataWithLimit(data []byte, contentEncoding string, maxSize int64) ([]byte, errors.EdgeX) {
+	if maxSize <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid maximum decompressed size %d", maxSize), nil)
+	}
+	var reader io.Reader
+	switch normalizeContentEncoding(contentEncoding) {
+	case "", common.ContentEncodingIdentity:
+		return data, nil
+	case common.ContentEncodingGzip:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the gzip data", err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case common.ContentEncodingZstd:
+		// bound the memory of the decoder as well, but allow the default window of the encoder so that the data
+		// compressed by CompressData can always be decompressed
+		zstdReader, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderMaxMemory(uint64(max(maxSize, zstdDefaultWindowSize))))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the zstd data", err)
+		}
+		defer zstdReader.Close()
+		reader = zstdReader
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content encoding '%s'", contentEncoding), nil)
+	}
+
+	// read one more byte than the maximum to tell the data of exactly the maximum size from the larger data
+	decompressed, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if goErrors.Is(err, zstd.ErrDecoderSizeExceeded) || goErrors.Is(err, zstd.ErrWindowSizeExceeded) ||
+		(err == nil && int64(len(decompressed)) > maxSize) {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded,
+			fmt.Sprintf("the data decompressed with %s exceeds the maximum size of %d bytes", contentEncoding, maxSize), err)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decompress data with %s", contentEncoding), err)
+	}
+	return decompressed, nil
+}
+
+func normalizeContentEncoding(contentEncoding string) string {
+	return strings.ToLower(strings.TrimSpace(contentEncoding))
+}
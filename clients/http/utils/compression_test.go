@@ -0,0 +1,214 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+func TestCompressAndDecompressData(t *testing.T) {
+	data := bytes.Repeat([]byte("edgex binary reading "), 1024)
+
+	tests := []struct {
+		name            string
+		contentEncoding string
+		expectedErr     bool
+	}{
+		{"gzip", common.ContentEncodingGzip, false},
+		{"zstd", common.ContentEncodingZstd, false},
+		{"upper case gzip", "GZIP", false},
+		{"unsupported", "br", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compressed, err := CompressData(data, tt.contentEncoding)
+			if tt.expectedErr {
+				require.Error(t, err)
+				_, err = DecompressData(data, tt.contentEncoding)
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Less(t, len(compressed), len(data))
+
+			decompressed, err := DecompressData(compressed, tt.contentEncoding)
+			require.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+		})
+	}
+}
+
+func TestDecompressData_NotCompressed(t *testing.T) {
+	data := []byte("plain data")
+	for _, contentEncoding := range []string{"", common.ContentEncodingIdentity} {
+		res, err := DecompressData(data, contentEncoding)
+		require.NoError(t, err)
+		assert.Equal(t, data, res)
+	}
+
+	_, err := DecompressData(data, common.ContentEncodingGzip)
+	require.Error(t, err)
+	_, err = DecompressData(data, common.ContentEncodingZstd)
+	require.Error(t, err)
+}
+
+func TestDecompressDataWithLimit(t *testing.T) {
+	// a small payload decompressing to a much larger size, like a decompression bomb
+	data := make([]byte, 1024*1024)
+
+	for _, contentEncoding := range []string{common.ContentEncodingGzip, common.ContentEncodingZstd} {
+		t.Run(contentEncoding, func(t *testing.T) {
+			compressed, err := CompressData(data, contentEncoding)
+			require.NoError(t, err)
+			require.Less(t, len(compressed), 64*1024)
+
+			decompressed, err := DecompressDataWithLimit(compressed, contentEncoding, int64(len(data)))
+			require.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+
+			_, err = DecompressDataWithLimit(compressed, contentEncoding, int64(len(data)-1))
+			require.Error(t, err)
+			assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+
+			_, err = DecompressDataWithLimit(compressed, contentEncoding, 0)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGetRequest_MaxDecompressedSize(t *testing.T) {
+	compressed, err := CompressData(make([]byte, 2048), common.ContentEncodingZstd)
+	require.NoError(t, err)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(common.ContentEncoding, common.ContentEncodingZstd)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(compressed)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		expectedError bool
+	}{
+		{"default", context.Background(), false},
+		{"not exceeded", WithMaxDecompressedSize(context.Background(), 2048), false},
+		{"exceeded", WithMaxDecompressedSize(context.Background(), 1024), true},
+		{"exceeded by the CompressionConfig", CompressionConfig{MaxDecompressedSize: 1024}.WithMaxDecompressedSize(context.Background()), true},
+		{"context prior to the CompressionConfig", CompressionConfig{MaxDecompressedSize: 1024}.WithMaxDecompressedSize(WithMaxDecompressedSize(context.Background(), 2048)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, _, err := GetRequestAndReturnBinaryRes(tt.ctx, ts.URL, common.ApiEventRoute, nil, nullAuthenticationInjector{})
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, res, 2048)
+		})
+	}
+}
+
+func TestCompressionConfig_ShouldCompress(t *testing.T) {
+	small := make([]byte, 10)
+	large := make([]byte, DefaultCompressionThreshold)
+
+	tests := []struct {
+		name     string
+		config   CompressionConfig
+		data     []byte
+		expected bool
+	}{
+		{"disabled", CompressionConfig{}, large, false},
+		{"below default threshold", CompressionConfig{ContentEncoding: common.ContentEncodingGzip}, small, false},
+		{"reach default threshold", CompressionConfig{ContentEncoding: common.ContentEncodingGzip}, large, true},
+		{"reach custom threshold", CompressionConfig{ContentEncoding: common.ContentEncodingZstd, Threshold: 10}, small, true},
+		{"below custom threshold", CompressionConfig{ContentEncoding: common.ContentEncodingZstd, Threshold: 11}, small, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.shouldCompress(tt.data))
+		})
+	}
+}
+
+func TestGetRequest_CompressedResponse(t *testing.T) {
+	expected := dtoCommon.NewCountResponse(testRequestId, "", http.StatusOK, 10)
+	for _, contentEncoding := range []string{common.ContentEncodingGzip, common.ContentEncodingZstd} {
+		t.Run(contentEncoding, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, contentEncoding, r.Header.Get(common.AcceptEncoding))
+				data, _ := common.GetCodecOrDefault(common.ContentTypeJSON).Marshal(expected)
+				compressed, err := CompressData(data, contentEncoding)
+				require.NoError(t, err)
+				w.Header().Set(common.ContentType, common.ContentTypeJSON)
+				w.Header().Set(common.ContentEncoding, contentEncoding)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(compressed)
+			}))
+			defer ts.Close()
+
+			ctx := context.WithValue(context.Background(), common.AcceptEncoding, contentEncoding) // nolint:staticcheck
+			var res dtoCommon.CountResponse
+			err := GetRequest(ctx, &res, ts.URL, common.ApiEventCountRoute, nil, nullAuthenticationInjector{})
+			require.NoError(t, err)
+			assert.Equal(t, expected, res)
+		})
+	}
+}
+
+func TestPostRequestWithCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 100)
+
+	tests := []struct {
+		name                    string
+		compression             CompressionConfig
+		expectedContentEncoding string
+	}{
+		{"no compression", CompressionConfig{}, ""},
+		{"below threshold", CompressionConfig{ContentEncoding: common.ContentEncodingGzip, Threshold: 1000}, ""},
+		{"gzip", CompressionConfig{ContentEncoding: common.ContentEncodingGzip, Threshold: 100}, common.ContentEncodingGzip},
+		{"zstd", CompressionConfig{ContentEncoding: common.ContentEncodingZstd, Threshold: 1}, common.ContentEncodingZstd},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contentEncoding := r.Header.Get(common.ContentEncoding)
+				assert.Equal(t, tt.expectedContentEncoding, contentEncoding)
+				body := new(bytes.Buffer)
+				_, err := body.ReadFrom(r.Body)
+				require.NoError(t, err)
+				decompressed, edgexErr := DecompressData(body.Bytes(), contentEncoding)
+				require.NoError(t, edgexErr)
+				assert.Equal(t, data, decompressed)
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer ts.Close()
+
+			var res dtoCommon.BaseResponse
+			err := PostRequestWithCompression(context.Background(), &res, ts.URL, common.ApiEventRoute, data, common.ContentTypeCBOR, tt.compression, nullAuthenticationInjector{})
+			require.NoError(t, err)
+		})
+	}
+
+	var res dtoCommon.BaseResponse
+	err := PostRequestWithCompression(context.Background(), &res, "http://localhost", common.ApiEventRoute, data, common.ContentTypeCBOR,
+		CompressionConfig{ContentEncoding: "br", Threshold: 1}, nullAuthenticationInjector{})
+	require.Error(t, err)
+}
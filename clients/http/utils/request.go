@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -8,7 +8,6 @@ package utils
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -87,6 +86,37 @@ func PostRequest(
 	return processRequest(ctx, returnValuePointer, req, authInjector)
 }
 
+// PostRequestWithCompression makes the post request with encoded data and return the body. The encoded data is
+// compressed according to the CompressionConfig when its size reaches the compression threshold.
+func PostRequestWithCompression(
+	ctx context.Context,
+	returnValuePointer interface{},
+	baseUrl string, requestPath string,
+	data []byte,
+	encoding string,
+	compression CompressionConfig, authInjector interfaces.AuthenticationInjector) errors.EdgeX {
+
+	var contentEncoding string
+	if compression.shouldCompress(data) {
+		compressed, err := CompressData(data, compression.ContentEncoding)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		data = compressed
+		contentEncoding = normalizeContentEncoding(compression.ContentEncoding)
+	}
+
+	req, err := CreateRequestWithEncodedData(ctx, http.MethodPost, baseUrl, requestPath, data, encoding)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if contentEncoding != "" {
+		req.Header.Set(common.ContentEncoding, contentEncoding)
+	}
+
+	return processRequest(ctx, returnValuePointer, req, authInjector)
+}
+
 // PostRequestWithRawData makes the post JSON request with raw data and return the body
 func PostRequestWithRawData(
 	ctx context.Context,
@@ -202,18 +232,19 @@ func DeleteRequestWithParams(ctx context.Context, returnValuePointer interface{}
 	return processRequest(ctx, returnValuePointer, req, authInjector)
 }
 
-// processRequest is a helper function to process the request and get the return value
+// processRequest is a helper function to process the request and get the return value.
+// The response body is decoded with the Codec registered for the response content type, and falls back to JSON.
 func processRequest(ctx context.Context,
 	returnValuePointer any, req *http.Request, authInjector interfaces.AuthenticationInjector) errors.EdgeX {
-	resp, err := SendRequest(ctx, req, authInjector)
+	resp, contentType, err := sendRequest(req, authInjector)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	// Check the response content length to avoid json unmarshal error
+	// Check the response content length to avoid unmarshal error
 	if len(resp) == 0 {
 		return nil
 	}
-	if err := json.Unmarshal(resp, returnValuePointer); err != nil {
+	if err := common.GetCodecOrDefault(contentType).Unmarshal(resp, returnValuePointer); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
 	}
 	return nil
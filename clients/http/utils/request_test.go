@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const testRequestId = "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+
+type nullAuthenticationInjector struct{}
+
+func (nullAuthenticationInjector) AddAuthenticationData(_ *http.Request) error { return nil }
+
+func (nullAuthenticationInjector) RoundTripper() http.RoundTripper { return http.DefaultTransport }
+
+// newNegotiatingServer responds with the content type negotiated from the Accept header
+func newNegotiatingServer(statusCode int, response any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		codec, err := common.NegotiateCodecFor(r.Header.Get(common.Accept), response)
+		if err != nil {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		data, _ := codec.Marshal(response)
+		w.Header().Set(common.ContentType, codec.ContentType())
+		w.WriteHeader(statusCode)
+		_, _ = w.Write(data)
+	}))
+}
+
+func TestGetRequest_ContentNegotiation(t *testing.T) {
+	expected := dtoCommon.NewCountResponse(testRequestId, "", http.StatusOK, 10)
+	ts := newNegotiatingServer(http.StatusOK, expected)
+	defer ts.Close()
+
+	tests := []struct {
+		name   string
+		accept string
+	}{
+		{"default JSON response", ""},
+		{"JSON response", common.ContentTypeJSON},
+		{"CBOR response", common.ContentTypeCBOR},
+		{"CBOR response by quality value", "application/json;q=0.1, application/cbor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.accept != "" {
+				ctx = context.WithValue(ctx, common.Accept, tt.accept) // nolint:staticcheck
+			}
+			var res dtoCommon.CountResponse
+			err := GetRequest(ctx, &res, ts.URL, common.ApiEventCountRoute, nil, nullAuthenticationInjector{})
+			require.NoError(t, err)
+			assert.Equal(t, expected, res)
+		})
+	}
+}
+
+func TestGetRequest_ErrorResponseWithCBOR(t *testing.T) {
+	errResp := dtoCommon.NewBaseResponse(testRequestId, "entity not found", http.StatusNotFound)
+	ts := newNegotiatingServer(http.StatusNotFound, errResp)
+	defer ts.Close()
+
+	ctx := context.WithValue(context.Background(), common.Accept, common.ContentTypeCBOR) // nolint:staticcheck
+	var res dtoCommon.CountResponse
+	err := GetRequest(ctx, &res, ts.URL, common.ApiEventCountRoute, nil, nullAuthenticationInjector{})
+	require.Error(t, err)
+	assert.Equal(t, string(errors.KindEntityDoesNotExist), err.Kind())
+	assert.Contains(t, err.Message(), errResp.Message)
+}
+
+func TestPostRequestWithRawData_ContentType(t *testing.T) {
+	type sample struct {
+		Name string `json:"name"`
+	}
+	expected := sample{Name: "test"}
+
+	tests := []struct {
+		name                string
+		contentType         string
+		expectedContentType string
+		decode              func([]byte, any) error
+	}{
+		{"default JSON body", "", common.ContentTypeJSON, common.GetCodecOrDefault(common.ContentTypeJSON).Unmarshal},
+		{"CBOR body", common.ContentTypeCBOR, common.ContentTypeCBOR, cbor.Unmarshal},
+		{"unregistered content type falls back to JSON encoding", common.ContentTypeYAML, common.ContentTypeYAML, common.GetCodecOrDefault(common.ContentTypeJSON).Unmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedContentType, r.Header.Get(common.ContentType))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var actual sample
+				require.NoError(t, tt.decode(body, &actual))
+				assert.Equal(t, expected, actual)
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer ts.Close()
+
+			ctx := context.Background()
+			if tt.contentType != "" {
+				ctx = context.WithValue(ctx, common.ContentType, tt.contentType) // nolint:staticcheck
+			}
+			var res dtoCommon.BaseResponse
+			err := PostRequestWithRawData(ctx, &res, ts.URL, common.ApiEventRoute, nil, expected, nullAuthenticationInjector{})
+			require.NoError(t, err)
+		})
+	}
+}
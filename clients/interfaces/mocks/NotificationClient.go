@@ -21,6 +21,36 @@ type NotificationClient struct {
 	mock.Mock
 }
 
+// AcknowledgeNotificationByToken provides a mock function with given fields: ctx, token
+func (_m *NotificationClient) AcknowledgeNotificationByToken(ctx context.Context, token string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeNotificationByToken")
+	}
+
+	var r0 common.BaseResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (common.BaseResponse, errors.EdgeX)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CleanupNotifications provides a mock function with given fields: ctx
 func (_m *NotificationClient) CleanupNotifications(ctx context.Context) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx)
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	common "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -47,6 +49,36 @@ func (_m *ScheduleActionRecordClient) AllScheduleActionRecords(ctx context.Conte
 	return r0, r1
 }
 
+// DeleteScheduleActionRecordsByAge provides a mock function with given fields: ctx, age
+func (_m *ScheduleActionRecordClient) DeleteScheduleActionRecordsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScheduleActionRecordsByAge")
+	}
+
+	var r0 common.BaseResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, int) (common.BaseResponse, errors.EdgeX)); ok {
+		return rf(ctx, age)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) common.BaseResponse); ok {
+		r0 = rf(ctx, age)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) errors.EdgeX); ok {
+		r1 = rf(ctx, age)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // LatestScheduleActionRecordsByJobName provides a mock function with given fields: ctx, jobName
 func (_m *ScheduleActionRecordClient) LatestScheduleActionRecordsByJobName(ctx context.Context, jobName string) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, jobName)
@@ -77,6 +109,96 @@ func (_m *ScheduleActionRecordClient) LatestScheduleActionRecordsByJobName(ctx c
 	return r0, r1
 }
 
+// ScheduleActionRecordsByActionType provides a mock function with given fields: ctx, actionType, status, start, end, offset, limit
+func (_m *ScheduleActionRecordClient) ScheduleActionRecordsByActionType(ctx context.Context, actionType string, status string, start int64, end int64, offset int, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, actionType, status, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleActionRecordsByActionType")
+	}
+
+	var r0 responses.MultiScheduleActionRecordsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)); ok {
+		return rf(ctx, actionType, status, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) responses.MultiScheduleActionRecordsResponse); ok {
+		r0 = rf(ctx, actionType, status, start, end, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiScheduleActionRecordsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, actionType, status, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ScheduleActionRecordsByAddress provides a mock function with given fields: ctx, address, status, start, end, offset, limit
+func (_m *ScheduleActionRecordClient) ScheduleActionRecordsByAddress(ctx context.Context, address string, status string, start int64, end int64, offset int, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, address, status, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleActionRecordsByAddress")
+	}
+
+	var r0 responses.MultiScheduleActionRecordsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)); ok {
+		return rf(ctx, address, status, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) responses.MultiScheduleActionRecordsResponse); ok {
+		r0 = rf(ctx, address, status, start, end, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiScheduleActionRecordsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, address, status, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ScheduleActionRecordsByDeviceName provides a mock function with given fields: ctx, deviceName, status, start, end, offset, limit
+func (_m *ScheduleActionRecordClient) ScheduleActionRecordsByDeviceName(ctx context.Context, deviceName string, status string, start int64, end int64, offset int, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName, status, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleActionRecordsByDeviceName")
+	}
+
+	var r0 responses.MultiScheduleActionRecordsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)); ok {
+		return rf(ctx, deviceName, status, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64, int, int) responses.MultiScheduleActionRecordsResponse); ok {
+		r0 = rf(ctx, deviceName, status, start, end, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiScheduleActionRecordsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName, status, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ScheduleActionRecordsByJobName provides a mock function with given fields: ctx, jobName, start, end, offset, limit
 func (_m *ScheduleActionRecordClient) ScheduleActionRecordsByJobName(ctx context.Context, jobName string, start int64, end int64, offset int, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, jobName, start, end, offset, limit)
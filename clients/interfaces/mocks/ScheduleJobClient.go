@@ -53,6 +53,36 @@ func (_m *ScheduleJobClient) Add(ctx context.Context, reqs []requests.AddSchedul
 	return r0, r1
 }
 
+// AllScheduleJobDependencies provides a mock function with given fields: ctx
+func (_m *ScheduleJobClient) AllScheduleJobDependencies(ctx context.Context) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllScheduleJobDependencies")
+	}
+
+	var r0 responses.ScheduleJobDependencyGraphResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) responses.ScheduleJobDependencyGraphResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(responses.ScheduleJobDependencyGraphResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllScheduleJobs provides a mock function with given fields: ctx, labels, offset, limit
 func (_m *ScheduleJobClient) AllScheduleJobs(ctx context.Context, labels []string, offset int, limit int) (responses.MultiScheduleJobsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, labels, offset, limit)
@@ -113,6 +143,36 @@ func (_m *ScheduleJobClient) DeleteScheduleJobByName(ctx context.Context, name s
 	return r0, r1
 }
 
+// LockScheduleJobByName provides a mock function with given fields: ctx, name
+func (_m *ScheduleJobClient) LockScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockScheduleJobByName")
+	}
+
+	var r0 common.BaseResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (common.BaseResponse, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ScheduleJobByName provides a mock function with given fields: ctx, name
 func (_m *ScheduleJobClient) ScheduleJobByName(ctx context.Context, name string) (responses.ScheduleJobResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -143,6 +203,66 @@ func (_m *ScheduleJobClient) ScheduleJobByName(ctx context.Context, name string)
 	return r0, r1
 }
 
+// ScheduleJobDependenciesByName provides a mock function with given fields: ctx, name
+func (_m *ScheduleJobClient) ScheduleJobDependenciesByName(ctx context.Context, name string) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleJobDependenciesByName")
+	}
+
+	var r0 responses.ScheduleJobDependencyGraphResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) responses.ScheduleJobDependencyGraphResponse); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(responses.ScheduleJobDependencyGraphResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ScheduleJobStatisticsByName provides a mock function with given fields: ctx, name, start, end
+func (_m *ScheduleJobClient) ScheduleJobStatisticsByName(ctx context.Context, name string, start int64, end int64) (responses.ScheduleJobStatisticsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleJobStatisticsByName")
+	}
+
+	var r0 responses.ScheduleJobStatisticsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) (responses.ScheduleJobStatisticsResponse, errors.EdgeX)); ok {
+		return rf(ctx, name, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) responses.ScheduleJobStatisticsResponse); ok {
+		r0 = rf(ctx, name, start, end)
+	} else {
+		r0 = ret.Get(0).(responses.ScheduleJobStatisticsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(ctx, name, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TriggerScheduleJobByName provides a mock function with given fields: ctx, name
 func (_m *ScheduleJobClient) TriggerScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, name)
@@ -173,6 +293,36 @@ func (_m *ScheduleJobClient) TriggerScheduleJobByName(ctx context.Context, name
 	return r0, r1
 }
 
+// UnlockScheduleJobByName provides a mock function with given fields: ctx, name
+func (_m *ScheduleJobClient) UnlockScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockScheduleJobByName")
+	}
+
+	var r0 common.BaseResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (common.BaseResponse, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) common.BaseResponse); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, reqs
 func (_m *ScheduleJobClient) Update(ctx context.Context, reqs []requests.UpdateScheduleJobRequest) ([]common.BaseResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, reqs)
@@ -109,6 +109,66 @@ func (_m *TransmissionClient) TransmissionById(ctx context.Context, id string) (
 	return r0, r1
 }
 
+// TransmissionsByChannelHost provides a mock function with given fields: ctx, host, offset, limit
+func (_m *TransmissionClient) TransmissionsByChannelHost(ctx context.Context, host string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, host, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransmissionsByChannelHost")
+	}
+
+	var r0 responses.MultiTransmissionsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (responses.MultiTransmissionsResponse, errors.EdgeX)); ok {
+		return rf(ctx, host, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiTransmissionsResponse); ok {
+		r0 = rf(ctx, host, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiTransmissionsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, host, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TransmissionsByChannelType provides a mock function with given fields: ctx, channelType, offset, limit
+func (_m *TransmissionClient) TransmissionsByChannelType(ctx context.Context, channelType string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, channelType, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransmissionsByChannelType")
+	}
+
+	var r0 responses.MultiTransmissionsResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (responses.MultiTransmissionsResponse, errors.EdgeX)); ok {
+		return rf(ctx, channelType, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiTransmissionsResponse); ok {
+		r0 = rf(ctx, channelType, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiTransmissionsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, channelType, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionsByNotificationId provides a mock function with given fields: ctx, id, offset, limit
 func (_m *TransmissionClient) TransmissionsByNotificationId(ctx context.Context, id string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, id, offset, limit)
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -41,10 +41,16 @@ type NotificationClient interface {
 	// Age is supposed in milliseconds since modified timestamp
 	// Please notice that this API is only for processed notifications (status = PROCESSED). If the deletion purpose includes each kind of notifications, please refer to cleanup API.
 	DeleteProcessedNotificationsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
-	// NotificationsByQueryConditions queries notifications with offset, limit, acknowledgement status, category and time range
+	// NotificationsByQueryConditions queries notifications with offset, limit, acknowledgement status and the query
+	// condition, which filters by category, time range, severity, labels, sender, status and acknowledgement.
+	// An error is returned if the ack and the Acknowledged filter of the query condition are both specified but conflict.
 	NotificationsByQueryConditions(ctx context.Context, offset, limit int, ack string, conditionReq requests.GetNotificationRequest) (responses.MultiNotificationsResponse, errors.EdgeX)
 	// DeleteNotificationByIds deletes notifications by ids
 	DeleteNotificationByIds(ctx context.Context, ids []string) (common.BaseResponse, errors.EdgeX)
 	// UpdateNotificationAckStatusByIds updates existing notification's acknowledgement status
 	UpdateNotificationAckStatusByIds(ctx context.Context, ack bool, ids []string) (common.BaseResponse, errors.EdgeX)
+	// AcknowledgeNotificationByToken acknowledges the notification authorized by the ack token, which is usually
+	// carried by the link in the notification content. The link should point to a landing page calling this method
+	// rather than to the API, which only accepts PUT.
+	AcknowledgeNotificationByToken(ctx context.Context, token string) (common.BaseResponse, errors.EdgeX)
 }
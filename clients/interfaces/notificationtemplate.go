@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// NotificationTemplateClient defines the interface for interactions with the NotificationTemplate endpoint on the EdgeX Foundry support-notifications service.
+type NotificationTemplateClient interface {
+	// Add adds new notification templates.
+	Add(ctx context.Context, reqs []requests.AddNotificationTemplateRequest) ([]common.BaseWithIdResponse, errors.EdgeX)
+	// Update updates notification templates.
+	Update(ctx context.Context, reqs []requests.UpdateNotificationTemplateRequest) ([]common.BaseResponse, errors.EdgeX)
+	// AllNotificationTemplates queries notification templates with offset and limit
+	AllNotificationTemplates(ctx context.Context, offset int, limit int) (responses.MultiNotificationTemplatesResponse, errors.EdgeX)
+	// NotificationTemplateByName queries notification template by name.
+	NotificationTemplateByName(ctx context.Context, name string) (responses.NotificationTemplateResponse, errors.EdgeX)
+	// DeleteNotificationTemplateByName deletes a notification template by name.
+	DeleteNotificationTemplateByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+}
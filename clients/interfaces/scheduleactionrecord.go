@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,6 +8,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -24,4 +25,12 @@ type ScheduleActionRecordClient interface {
 	ScheduleActionRecordsByJobName(ctx context.Context, jobName string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
 	// ScheduleActionRecordsByJobNameAndStatus query schedule action records with jobName, status, start, end, offset, and limit
 	ScheduleActionRecordsByJobNameAndStatus(ctx context.Context, jobName, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// ScheduleActionRecordsByActionType queries schedule action records with action type, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+	ScheduleActionRecordsByActionType(ctx context.Context, actionType, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// ScheduleActionRecordsByDeviceName queries the DEVICECONTROL schedule action records with deviceName, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+	ScheduleActionRecordsByDeviceName(ctx context.Context, deviceName, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// ScheduleActionRecordsByAddress queries the REST schedule action records with address, status, start, end, offset, and limit, where all the statuses are queried if status is empty
+	ScheduleActionRecordsByAddress(ctx context.Context, address, status string, start, end int64, offset, limit int) (responses.MultiScheduleActionRecordsResponse, errors.EdgeX)
+	// DeleteScheduleActionRecordsByAge deletes the schedule action records that are older than age in milliseconds
+	DeleteScheduleActionRecordsByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX)
 }
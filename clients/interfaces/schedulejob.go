@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -31,4 +31,16 @@ type ScheduleJobClient interface {
 	DeleteScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
 	// TriggerScheduleJobByName triggers a schedule job by name.
 	TriggerScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// LockScheduleJobByName locks a schedule job by name, which stops the job from being scheduled.
+	LockScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// UnlockScheduleJobByName unlocks a schedule job by name, which resumes the job scheduling.
+	UnlockScheduleJobByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+	// ScheduleJobStatisticsByName returns the statistics of the schedule action records of a schedule job by name
+	// within the time range from start to end in milliseconds.
+	ScheduleJobStatisticsByName(ctx context.Context, name string, start, end int64) (responses.ScheduleJobStatisticsResponse, errors.EdgeX)
+	// AllScheduleJobDependencies returns the dependency graph of all schedule jobs.
+	AllScheduleJobDependencies(ctx context.Context) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX)
+	// ScheduleJobDependenciesByName returns the dependency graph of a schedule job by name, including its upstream
+	// and downstream jobs.
+	ScheduleJobDependenciesByName(ctx context.Context, name string) (responses.ScheduleJobDependencyGraphResponse, errors.EdgeX)
 }
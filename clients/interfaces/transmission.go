@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -29,4 +29,8 @@ type TransmissionClient interface {
 	TransmissionsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
 	// TransmissionsByNotificationId query transmissions with notification id, offset and limit
 	TransmissionsByNotificationId(ctx context.Context, id string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// TransmissionsByChannelType query transmissions with channel type, e.g. EMAIL or MQTT, offset and limit
+	TransmissionsByChannelType(ctx context.Context, channelType string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
+	// TransmissionsByChannelHost query transmissions with the host of the channel, offset and limit
+	TransmissionsByChannelHost(ctx context.Context, host string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)
 }
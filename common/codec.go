@@ -0,0 +1,241 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// Codec encodes and decodes the request and response DTOs with a specific content type
+type Codec interface {
+	// ContentType returns the media type handled by the Codec, e.g. application/json
+	ContentType() string
+	// Marshal encodes the value
+	Marshal(v any) ([]byte, error)
+	// Unmarshal decodes the data into the value pointed to by v
+	Unmarshal(data []byte, v any) error
+}
+
+// SelectiveCodec is a Codec which only encodes some types, e.g. the protobuf Codec only encodes the DTOs which
+// provide their own protobuf wire format
+type SelectiveCodec interface {
+	Codec
+	// CanMarshal checks whether the value can be encoded by the Codec
+	CanMarshal(v any) bool
+}
+
+var (
+	codecMutex sync.RWMutex
+	codecs     = map[string]Codec{
+		ContentTypeJSON:     jsonCodec{},
+		ContentTypeCBOR:     cborCodec{},
+		ContentTypeProtobuf: protobufCodec{},
+	}
+)
+
+// RegisterCodec registers the Codec for its content type, and replaces the Codec previously registered for the same content type
+func RegisterCodec(codec Codec) {
+	codecMutex.Lock()
+	defer codecMutex.Unlock()
+	codecs[mediaType(codec.ContentType())] = codec
+}
+
+// GetCodec returns the Codec registered for the content type. The media type parameters, e.g. charset, are ignored.
+func GetCodec(contentType string) (Codec, errors.EdgeX) {
+	codecMutex.RLock()
+	defer codecMutex.RUnlock()
+	codec, ok := codecs[mediaType(contentType)]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no codec registered for the content type '%s'", contentType), nil)
+	}
+	return codec, nil
+}
+
+// GetCodecOrDefault returns the Codec registered for the content type, or the JSON Codec if there is no such Codec
+func GetCodecOrDefault(contentType string) Codec {
+	codec, err := GetCodec(contentType)
+	if err != nil {
+		return jsonCodec{}
+	}
+	return codec
+}
+
+// NegotiateCodec returns the registered Codec that best matches the Accept header value, according to the
+// quality values and the specificity of the media ranges. The JSON Codec is preferred when several codecs are
+// equally acceptable, and is returned when the Accept header is empty. An error is returned if none of the
+// registered codecs is acceptable.
+func NegotiateCodec(accept string) (Codec, errors.EdgeX) {
+	codec, ok := negotiateCodec(accept, nil)
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("none of the accepted content types '%s' is supported", accept), nil)
+	}
+	return codec, nil
+}
+
+// NegotiateCodecFor returns the Codec negotiated like NegotiateCodec, but only among the codecs which can encode the
+// value, e.g. the protobuf Codec is skipped for the response types without the protobuf wire format. An error is
+// returned if none of the acceptable codecs can encode the value.
+func NegotiateCodecFor(accept string, v any) (Codec, errors.EdgeX) {
+	codec, ok := negotiateCodec(accept, v)
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("none of the accepted content types '%s' is supported for the type %T", accept, v), nil)
+	}
+	return codec, nil
+}
+
+// negotiateCodec negotiates the Codec from the Accept header value, and only the codecs which can encode the value
+// are negotiated if the value is not nil
+func negotiateCodec(accept string, v any) (Codec, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return jsonCodec{}, true
+	}
+
+	ranges := parseAccept(accept)
+	codecMutex.RLock()
+	defer codecMutex.RUnlock()
+	usable := func(codec Codec) bool {
+		selective, ok := codec.(SelectiveCodec)
+		return v == nil || !ok || selective.CanMarshal(v)
+	}
+	// iterate the content types in order so that the negotiation result is deterministic
+	contentTypes := make([]string, 0, len(codecs))
+	for contentType, codec := range codecs {
+		if usable(codec) {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	sort.Strings(contentTypes)
+	for _, r := range ranges {
+		if r.quality <= 0 {
+			continue
+		}
+		if codec, ok := codecs[r.mediaType]; ok && usable(codec) {
+			return codec, true
+		}
+		if codec, ok := codecs[ContentTypeJSON]; ok && r.matches(ContentTypeJSON) && usable(codec) {
+			return codec, true
+		}
+		for _, contentType := range contentTypes {
+			if r.matches(contentType) {
+				return codecs[contentType], true
+			}
+		}
+	}
+	return nil, false
+}
+
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// matches checks whether the content type is covered by the media range, e.g. application/* covers application/cbor
+func (r mediaRange) matches(contentType string) bool {
+	if r.mediaType == "*/*" || r.mediaType == contentType {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(r.mediaType, "/*")
+	return ok && strings.HasPrefix(contentType, prefix+"/")
+}
+
+// specificity ranks the exact media types before the partial and full wildcards
+func (r mediaRange) specificity() int {
+	switch {
+	case r.mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(r.mediaType, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// parseAccept parses the Accept header value into media ranges sorted by the quality values and specificity
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, CommaSeparator) {
+		mt, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mt, quality: quality})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		if ranges[i].quality != ranges[j].quality {
+			return ranges[i].quality > ranges[j].quality
+		}
+		return ranges[i].specificity() > ranges[j].specificity()
+	})
+	return ranges
+}
+
+// mediaType returns the lower-case media type of the content type without parameters
+func mediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mt
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string { return ContentTypeJSON }
+
+func (jsonCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+type cborCodec struct{}
+
+func (cborCodec) ContentType() string { return ContentTypeCBOR }
+
+func (cborCodec) Marshal(v any) ([]byte, error) { return cbor.Marshal(v) }
+
+func (cborCodec) Unmarshal(data []byte, v any) error { return cbor.Unmarshal(data, v) }
+
+// protobufCodec supports the DTOs which provide their own protobuf wire format, e.g. Event and AddEventRequest
+type protobufCodec struct{}
+
+func (protobufCodec) ContentType() string { return ContentTypeProtobuf }
+
+func (protobufCodec) CanMarshal(v any) bool {
+	_, ok := v.(interface{ MarshalProtobuf() ([]byte, error) })
+	return ok
+}
+
+func (protobufCodec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(interface{ MarshalProtobuf() ([]byte, error) })
+	if !ok {
+		return nil, fmt.Errorf("type %T doesn't support the protobuf encoding", v)
+	}
+	return m.MarshalProtobuf()
+}
+
+func (protobufCodec) Unmarshal(data []byte, v any) error {
+	u, ok := v.(interface{ UnmarshalProtobuf([]byte) error })
+	if !ok {
+		return fmt.Errorf("type %T doesn't support the protobuf decoding", v)
+	}
+	return u.UnmarshalProtobuf(data)
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCodec struct {
+	jsonCodec
+	contentType string
+}
+
+func (c testCodec) ContentType() string { return c.contentType }
+
+func TestGetCodec(t *testing.T) {
+	tests := []struct {
+		name                string
+		contentType         string
+		expectedContentType string
+		expectedErr         bool
+	}{
+		{"JSON", ContentTypeJSON, ContentTypeJSON, false},
+		{"JSON with charset", "application/json; charset=utf-8", ContentTypeJSON, false},
+		{"CBOR", ContentTypeCBOR, ContentTypeCBOR, false},
+		{"CBOR upper case", "Application/CBOR", ContentTypeCBOR, false},
+		{"Protobuf", ContentTypeProtobuf, ContentTypeProtobuf, false},
+		{"unsupported content type", ContentTypeYAML, "", true},
+		{"empty content type", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := GetCodec(tt.contentType)
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, ContentTypeJSON, GetCodecOrDefault(tt.contentType).ContentType())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContentType, codec.ContentType())
+		})
+	}
+}
+
+func TestRegisterCodec(t *testing.T) {
+	contentType := "application/x-test"
+	_, err := GetCodec(contentType)
+	require.Error(t, err)
+
+	RegisterCodec(testCodec{contentType: contentType})
+	t.Cleanup(func() {
+		codecMutex.Lock()
+		delete(codecs, contentType)
+		codecMutex.Unlock()
+	})
+
+	codec, err := GetCodec(contentType)
+	require.NoError(t, err)
+	assert.Equal(t, contentType, codec.ContentType())
+
+	codec, err = NegotiateCodec("application/x-test;q=0.9, application/json;q=0.5")
+	require.NoError(t, err)
+	assert.Equal(t, contentType, codec.ContentType())
+}
+
+func TestNegotiateCodec(t *testing.T) {
+	tests := []struct {
+		name                string
+		accept              string
+		expectedContentType string
+		expectedErr         bool
+	}{
+		{"empty", "", ContentTypeJSON, false},
+		{"JSON", ContentTypeJSON, ContentTypeJSON, false},
+		{"CBOR", ContentTypeCBOR, ContentTypeCBOR, false},
+		{"any", "*/*", ContentTypeJSON, false},
+		{"application wildcard", "application/*", ContentTypeJSON, false},
+		{"first acceptable", "text/html, application/cbor", ContentTypeCBOR, false},
+		{"quality values", "application/json;q=0.5, application/cbor;q=0.8", ContentTypeCBOR, false},
+		{"exact type preferred over wildcard", "*/*, application/cbor", ContentTypeCBOR, false},
+		{"not acceptable", "application/json;q=0, application/cbor;q=0", "", true},
+		{"unsupported", "text/html", "", true},
+		{"invalid quality value skipped", "application/cbor;q=abc, application/x-protobuf", ContentTypeProtobuf, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := NegotiateCodec(tt.accept)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContentType, codec.ContentType())
+		})
+	}
+}
+
+type protobufSample struct{}
+
+func (protobufSample) MarshalProtobuf() ([]byte, error) { return []byte{}, nil }
+
+func TestNegotiateCodecFor(t *testing.T) {
+	type plainSample struct{}
+	tests := []struct {
+		name                string
+		accept              string
+		value               any
+		expectedContentType string
+		expectedErr         bool
+	}{
+		{"protobuf supported", ContentTypeProtobuf, protobufSample{}, ContentTypeProtobuf, false},
+		{"protobuf unsupported", ContentTypeProtobuf, plainSample{}, "", true},
+		{"protobuf unsupported, next acceptable", "application/x-protobuf, application/cbor;q=0.5", plainSample{}, ContentTypeCBOR, false},
+		{"protobuf unsupported, wildcard", "application/x-protobuf, */*;q=0.1", plainSample{}, ContentTypeJSON, false},
+		{"protobuf unsupported, nil value", ContentTypeProtobuf, nil, ContentTypeProtobuf, false},
+		{"empty", "", plainSample{}, ContentTypeJSON, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := NegotiateCodecFor(tt.accept, tt.value)
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "plainSample")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContentType, codec.ContentType())
+		})
+	}
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	type sample struct {
+		Name  string `json:"name"`
+		Value int    `json:"value"`
+	}
+	expected := sample{Name: "test", Value: 10}
+	for _, contentType := range []string{ContentTypeJSON, ContentTypeCBOR} {
+		t.Run(contentType, func(t *testing.T) {
+			codec, err := GetCodec(contentType)
+			require.NoError(t, err)
+			data, goErr := codec.Marshal(expected)
+			require.NoError(t, goErr)
+			var result sample
+			require.NoError(t, codec.Unmarshal(data, &result))
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	t.Run("protobuf is unsupported by the plain struct", func(t *testing.T) {
+		codec, err := GetCodec(ContentTypeProtobuf)
+		require.NoError(t, err)
+		_, goErr := codec.Marshal(expected)
+		require.Error(t, goErr)
+		require.Error(t, codec.Unmarshal([]byte{}, &expected))
+	})
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -82,6 +82,10 @@ const (
 	ApiSubscriptionByLabelRoute    = ApiSubscriptionRoute + "/" + Label + "/:" + Label
 	ApiSubscriptionByReceiverRoute = ApiSubscriptionRoute + "/" + Receiver + "/:" + Receiver
 
+	ApiNotificationTemplateRoute       = ApiBase + "/notificationtemplate"
+	ApiAllNotificationTemplateRoute    = ApiNotificationTemplateRoute + "/" + All
+	ApiNotificationTemplateByNameRoute = ApiNotificationTemplateRoute + "/" + Name + "/:" + Name
+
 	ApiNotificationRoute                   = ApiBase + "/notification"
 	ApiNotificationCleanupRoute            = ApiBase + "/cleanup"
 	ApiNotificationCleanupByAgeRoute       = ApiNotificationCleanupRoute + "/" + Age + "/:" + Age
@@ -95,6 +99,7 @@ const (
 	ApiNotificationBySubscriptionNameRoute = ApiNotificationRoute + "/" + Subscription + "/" + Name + "/:" + Name
 	ApiNotificationAcknowledgeByIdsRoute   = ApiNotificationRoute + "/" + Acknowledge + "/" + Ids + "/:" + Ids
 	ApiNotificationUnacknowledgeByIdsRoute = ApiNotificationRoute + "/" + Unacknowledge + "/" + Ids + "/:" + Ids
+	ApiNotificationAcknowledgeByTokenRoute = ApiNotificationRoute + "/" + Acknowledge + "/" + Token + "/:" + Token
 
 	ApiTransmissionRoute                   = ApiBase + "/transmission"
 	ApiAllTransmissionRoute                = ApiTransmissionRoute + "/" + All
@@ -104,12 +109,21 @@ const (
 	ApiTransmissionByTimeRangeRoute        = ApiTransmissionRoute + "/" + Start + "/:" + Start + "/" + End + "/:" + End
 	ApiTransmissionByStatusRoute           = ApiTransmissionRoute + "/" + Status + "/:" + Status
 	ApiTransmissionByNotificationIdRoute   = ApiTransmissionRoute + "/" + Notification + "/" + Id + "/:" + Id
-
-	ApiScheduleJobRoute              = ApiBase + "/job"
-	ApiAllScheduleJobRoute           = ApiScheduleJobRoute + "/" + All
-	ApiTriggerScheduleJobRoute       = ApiScheduleJobRoute + "/" + Trigger
-	ApiScheduleJobByNameRoute        = ApiScheduleJobRoute + "/" + Name + "/:" + Name
-	ApiTriggerScheduleJobByNameRoute = ApiTriggerScheduleJobRoute + "/" + Name + "/:" + Name
+	ApiTransmissionByChannelTypeRoute      = ApiTransmissionRoute + "/" + Channel + "/" + Type + "/:" + Type
+	ApiTransmissionByChannelHostRoute      = ApiTransmissionRoute + "/" + Channel + "/" + Host + "/:" + Host
+
+	ApiScheduleJobRoute                 = ApiBase + "/job"
+	ApiAllScheduleJobRoute              = ApiScheduleJobRoute + "/" + All
+	ApiTriggerScheduleJobRoute          = ApiScheduleJobRoute + "/" + Trigger
+	ApiScheduleJobByNameRoute           = ApiScheduleJobRoute + "/" + Name + "/:" + Name
+	ApiTriggerScheduleJobByNameRoute    = ApiTriggerScheduleJobRoute + "/" + Name + "/:" + Name
+	ApiLockScheduleJobByNameRoute       = ApiScheduleJobRoute + "/" + Lock + "/" + Name + "/:" + Name
+	ApiUnlockScheduleJobByNameRoute     = ApiScheduleJobRoute + "/" + Unlock + "/" + Name + "/:" + Name
+	ApiScheduleJobStatisticsRoute       = ApiScheduleJobRoute + "/" + Statistics
+	ApiScheduleJobStatisticsByNameRoute = ApiScheduleJobStatisticsRoute + "/" + Name + "/:" + Name
+	ApiScheduleJobDependencyRoute       = ApiScheduleJobRoute + "/" + Dependency
+	ApiAllScheduleJobDependencyRoute    = ApiScheduleJobDependencyRoute + "/" + All
+	ApiScheduleJobDependencyByNameRoute = ApiScheduleJobDependencyRoute + "/" + Name + "/:" + Name
 
 	ApiScheduleActionRecordRoute                        = ApiBase + "/scheduleactionrecord"
 	ApiAllScheduleActionRecordRoute                     = ApiScheduleActionRecordRoute + "/" + All
@@ -117,6 +131,10 @@ const (
 	ApiScheduleActionRecordRouteByStatusRoute           = ApiScheduleActionRecordRoute + "/" + Status + "/:" + Status
 	ApiScheduleActionRecordRouteByJobNameRoute          = ApiScheduleActionRecordRoute + "/" + Job + "/" + Name + "/:" + Name
 	ApiScheduleActionRecordRouteByJobNameAndStatusRoute = ApiScheduleActionRecordRoute + "/" + Job + "/" + Name + "/:" + Name + "/" + Status + "/:" + Status
+	ApiScheduleActionRecordRouteByActionTypeRoute       = ApiScheduleActionRecordRoute + "/" + Action + "/" + Type + "/:" + Type
+	ApiScheduleActionRecordRouteByDeviceNameRoute       = ApiScheduleActionRecordRoute + "/" + Device + "/" + Name + "/:" + Name
+	ApiScheduleActionRecordRouteByAddressRoute          = ApiScheduleActionRecordRoute + "/" + Address
+	ApiScheduleActionRecordByAgeRoute                   = ApiScheduleActionRecordRoute + "/" + Age + "/:" + Age
 
 	ApiConfigRoute         = ApiBase + "/config"
 	ApiPingRoute           = ApiBase + "/ping"
@@ -194,6 +212,14 @@ const (
 	ServiceId     = "serviceId"
 	Job           = "job"
 	Trigger       = "trigger"
+	Lock          = "lock"
+	Unlock        = "unlock"
+	Statistics    = "statistics"
+	Dependency    = "dependency"
+	Action        = "action"
+	Address       = "address"
+	Channel       = "channel"
+	Host          = "host"
 	Latest        = "latest"
 	Ack           = "ack"
 	Acknowledge   = "acknowledge"
@@ -264,13 +290,46 @@ const (
 // Constants for Address
 const (
 	// Type
-	REST   = "REST"
-	MQTT   = "MQTT"
-	EMAIL  = "EMAIL"
-	ZeroMQ = "ZeroMQ"
-	HTTP   = "http"
-	TCP    = "tcp"
-	TCPS   = "tcps"
+	REST    = "REST"
+	MQTT    = "MQTT"
+	EMAIL   = "EMAIL"
+	ZeroMQ  = "ZeroMQ"
+	WEBHOOK = "WEBHOOK"
+	KAFKA   = "KAFKA"
+	NATS    = "NATS"
+	HTTP    = "http"
+	TCP     = "tcp"
+	TCPS    = "tcps"
+)
+
+// Constants for WEBHOOK Address
+const (
+	// WebhookSignatureHeader is the default header carrying the HMAC-SHA256 signature of the webhook request body
+	WebhookSignatureHeader = "X-EdgeX-Signature"
+	// WebhookSecretKey is the key of the signing secret stored in the SecretPath of the WEBHOOK address
+	WebhookSecretKey = "secret"
+)
+
+// Constants for NotificationQueryCondition
+const (
+	// LabelMatchAny matches the notifications with any of the labels
+	LabelMatchAny = "ANY"
+	// LabelMatchAll matches the notifications with all the labels
+	LabelMatchAll = "ALL"
+	// SortOrderAsc sorts the query result by the created timestamp in ascending order
+	SortOrderAsc = "ASC"
+	// SortOrderDesc sorts the query result by the created timestamp in descending order
+	SortOrderDesc = "DESC"
+)
+
+// Constants for Subscription GroupingKeys, which are the Notification fields identifying the repeated notifications
+const (
+	GroupingKeyCategory = "category"
+	GroupingKeyLabels   = "labels"
+	GroupingKeySender   = "sender"
+	GroupingKeySeverity = "severity"
+	GroupingKeyContent  = "content"
+	GroupingKeyDedupKey = "dedupKey"
 )
 
 // Constants for SMA Operation Action
@@ -292,16 +351,29 @@ const (
 const (
 	DefInterval           = "INTERVAL"
 	DefCron               = "CRON"
+	DefOnce               = "ONCE"
+	DefCalendar           = "CALENDAR"
 	ActionEdgeXMessageBus = "EDGEXMESSAGEBUS"
 	ActionREST            = "REST"
 	ActionDeviceControl   = "DEVICECONTROL"
+	ActionNotification    = "NOTIFICATION"
+	ActionKVS             = "KVS"
+)
+
+// Constants for ScheduleJobDependency Condition
+const (
+	// DependencyOnCompleted triggers the dependent job on any completion of the upstream job
+	DependencyOnCompleted = "COMPLETED"
+	// DependencyOnSucceeded triggers the dependent job on the successful completion of the upstream job only
+	DependencyOnSucceeded = "SUCCEEDED"
 )
 
 // Constants for Edgex Environment variable
 const (
-	EnvEncodeAllEvents      = "EDGEX_ENCODE_ALL_EVENTS_CBOR"
-	EnvMessageCborEncode    = "EDGEX_MSG_CBOR_ENCODE"
-	EnvOptimizeEventPayload = "EDGEX_OPTIMIZE_EVENT_PAYLOAD"
+	EnvEncodeAllEvents         = "EDGEX_ENCODE_ALL_EVENTS_CBOR"
+	EnvEncodeAllEventsProtobuf = "EDGEX_ENCODE_ALL_EVENTS_PROTOBUF"
+	EnvMessageCborEncode       = "EDGEX_MSG_CBOR_ENCODE"
+	EnvOptimizeEventPayload    = "EDGEX_OPTIMIZE_EVENT_PAYLOAD"
 )
 
 // Miscellaneous constants
@@ -332,15 +404,25 @@ const (
 
 // Constants related to the possible content types supported by the APIs
 const (
-	Accept          = "Accept"
-	ContentType     = "Content-Type"
-	ContentLength   = "Content-Length"
-	ContentTypeCBOR = "application/cbor"
-	ContentTypeJSON = "application/json"
-	ContentTypeTOML = "application/toml"
-	ContentTypeYAML = "application/x-yaml"
-	ContentTypeText = "text/plain"
-	ContentTypeXML  = "application/xml"
+	Accept              = "Accept"
+	ContentType         = "Content-Type"
+	ContentLength       = "Content-Length"
+	ContentTypeCBOR     = "application/cbor"
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeTOML     = "application/toml"
+	ContentTypeYAML     = "application/x-yaml"
+	ContentTypeText     = "text/plain"
+	ContentTypeXML      = "application/xml"
+)
+
+// Constants related to the content codings supported by the APIs
+const (
+	AcceptEncoding          = "Accept-Encoding"
+	ContentEncoding         = "Content-Encoding"
+	ContentEncodingGzip     = "gzip"
+	ContentEncodingZstd     = "zstd"
+	ContentEncodingIdentity = "identity"
 )
 
 // Constants related to System Events
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,7 +8,12 @@ package common
 import (
 	"fmt"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+	// embed the IANA time zone database so that the time zones are resolved consistently regardless of the host
+	_ "time/tzdata"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
@@ -109,3 +114,60 @@ func StrSliceFromProperties(key string, properties map[string]any) []string {
 	}
 	return []string{}
 }
+
+// ParseDurationWithDay extends duration string parsing to support the "d" (day) unit.
+// It returns a boolean indicating whether the string is valid, along with the corresponding Duration value.
+func ParseDurationWithDay(durationStr string) (bool, time.Duration) {
+	// Duration string should not be empty
+	if durationStr == "" {
+		return false, time.Duration(0)
+	}
+
+	var totalDuration time.Duration
+
+	// Regex to find all day fragments like "2.2d", "1.5d", "1d1d", etc.
+	re := regexp.MustCompile(`([\d.]+)d`)
+	matches := re.FindAllStringSubmatch(durationStr, -1)
+
+	// Sum up all matched day durations
+	for _, match := range matches {
+		if len(match) != 2 {
+			continue
+		}
+		day, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return false, 0
+		}
+		// Converts days to hours and adds up to the total duration
+		totalDuration += time.Duration(day * float64(24*time.Hour))
+	}
+
+	// Remove all day fragments from the original string to get the rest
+	// so we're left with only the remaining duration (e.g., "5h30m")
+	durationStr = re.ReplaceAllString(durationStr, "")
+	durationStr = strings.TrimSpace(durationStr)
+
+	// Parse the remaining standard duration if any
+	if durationStr != "" {
+		remainingDuration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return false, totalDuration
+		}
+
+		totalDuration += remainingDuration
+	}
+	return true, totalDuration
+}
+
+// LoadTimeZone returns the location of the IANA time zone name, e.g. America/New_York or UTC. The empty name and
+// Local are rejected since they don't identify a zone independently of the host.
+func LoadTimeZone(name string) (*time.Location, errors.EdgeX) {
+	if name == "" || name == "Local" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid time zone '%s', an IANA time zone name is required", name), nil)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown time zone '%s'", name), err)
+	}
+	return location, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2023 IOTech Ltd
+// Copyright (C) 2023-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -61,3 +61,28 @@ func TestPathBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadTimeZone(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeZone    string
+		expectedErr bool
+	}{
+		{"valid, UTC", "UTC", false},
+		{"valid, IANA time zone", "Asia/Taipei", false},
+		{"invalid, empty", "", true},
+		{"invalid, Local", "Local", true},
+		{"invalid, unknown time zone", "Mars/Olympus_Mons", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, err := LoadTimeZone(tt.timeZone)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.timeZone, location.String())
+		})
+	}
+}
@@ -1,7 +1,7 @@
 //go:build !no_dto_validator
 
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -178,50 +177,6 @@ func ValidateDuration(fl validator.FieldLevel) bool {
 	return true
 }
 
-// ParseDurationWithDay extends duration string parsing to support the "d" (day) unit.
-// It returns a boolean indicating whether the string is valid, along with the corresponding Duration value.
-func ParseDurationWithDay(durationStr string) (bool, time.Duration) {
-	// Duration string should not be empty
-	if durationStr == "" {
-		return false, time.Duration(0)
-	}
-
-	var totalDuration time.Duration
-
-	// Regex to find all day fragments like "2.2d", "1.5d", "1d1d", etc.
-	re := regexp.MustCompile(`([\d.]+)d`)
-	matches := re.FindAllStringSubmatch(durationStr, -1)
-
-	// Sum up all matched day durations
-	for _, match := range matches {
-		if len(match) != 2 {
-			continue
-		}
-		day, err := strconv.ParseFloat(match[1], 64)
-		if err != nil {
-			return false, 0
-		}
-		// Converts days to hours and adds up to the total duration
-		totalDuration += time.Duration(day * float64(24*time.Hour))
-	}
-
-	// Remove all day fragments from the original string to get the rest
-	// so we're left with only the remaining duration (e.g., "5h30m")
-	durationStr = re.ReplaceAllString(durationStr, "")
-	durationStr = strings.TrimSpace(durationStr)
-
-	// Parse the remaining standard duration if any
-	if durationStr != "" {
-		remainingDuration, err := time.ParseDuration(durationStr)
-		if err != nil {
-			return false, totalDuration
-		}
-
-		totalDuration += remainingDuration
-	}
-	return true, totalDuration
-}
-
 // ValidateDtoUuid used to check the UpdateDTO uuid pointer value
 // Currently, required_without can not correct work with other tag, so write custom tag instead.
 // Issue can refer to https://github.com/go-playground/validator/issues/624
@@ -0,0 +1,235 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cron parses the cron expressions of the CRON schedule definition and computes their fire times.
+//
+// The supported expressions are:
+//   - 5 fields: minute hour day-of-month month day-of-week
+//   - 6 fields: second minute hour day-of-month month day-of-week
+//   - macros: @yearly (@annually), @monthly, @weekly, @daily (@midnight), @hourly and @every <duration>
+//
+// Each field accepts *, ?, values, ranges (a-b), steps (*/n, a/n, a-b/n) and comma-separated lists. Months and days
+// of week also accept the case-insensitive names JAN-DEC and SUN-SAT, and 7 is an alias of Sunday. An expression
+// may start with the CRON_TZ=<IANA zone> or TZ=<IANA zone> prefix to evaluate the schedule in that time zone.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	cronTZPrefix = "CRON_TZ="
+	tzPrefix     = "TZ="
+	everyMacro   = "@every"
+)
+
+// field describes the range and names of a cron field
+type field struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	secondField = field{name: "second", min: 0, max: 59}
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day-of-month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// day-of-week accepts 7 as Sunday, which is folded into 0 after parsing
+	dowField = field{name: "day-of-week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Parse parses the cron expression into a Schedule. The returned error describes the invalid part of the expression.
+func Parse(spec string) (Schedule, errors.EdgeX) {
+	expr := strings.TrimSpace(spec)
+	if expr == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "cron expression must not be empty", nil)
+	}
+
+	location := time.Local
+	if strings.HasPrefix(expr, cronTZPrefix) || strings.HasPrefix(expr, tzPrefix) {
+		zone, rest, _ := strings.Cut(expr, " ")
+		_, zone, _ = strings.Cut(zone, "=")
+		var err error
+		if location, err = time.LoadLocation(zone); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid cron expression '%s': unknown time zone '%s'", spec, zone), err)
+		}
+		expr = strings.TrimSpace(rest)
+		if expr == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid cron expression '%s': missing the expression after the time zone", spec), nil)
+		}
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		return parseMacro(spec, expr, location)
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		// the 5-field expression fires at the first second of the minute
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid cron expression '%s': expected 5 or 6 fields, got %d", spec, len(fields)), nil)
+	}
+	return parseFields(spec, fields, location)
+}
+
+func parseMacro(spec, expr string, location *time.Location) (Schedule, errors.EdgeX) {
+	if rest, ok := strings.CutPrefix(expr, everyMacro); ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t') {
+		interval := strings.TrimSpace(rest)
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid cron expression '%s': invalid @every duration '%s'", spec, interval), err)
+		}
+		if d < time.Second {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid cron expression '%s': @every duration must be at least 1s", spec), nil)
+		}
+		return everySchedule{interval: d.Truncate(time.Second)}, nil
+	}
+
+	fields, ok := macros[strings.ToLower(expr)]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid cron expression '%s': unknown macro '%s'", spec, expr), nil)
+	}
+	return parseFields(spec, strings.Fields(fields), location)
+}
+
+func parseFields(spec string, fields []string, location *time.Location) (Schedule, errors.EdgeX) {
+	s := &specSchedule{location: location}
+	targets := []struct {
+		bits  *uint64
+		field field
+	}{
+		{&s.second, secondField},
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	}
+	for i, target := range targets {
+		bits, err := parseField(fields[i], target.field)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid cron expression '%s': invalid %s field '%s': %s", spec, target.field.name, fields[i], err.Error()), nil)
+		}
+		*target.bits = bits
+	}
+
+	s.domRestricted = !isWildcard(fields[3])
+	s.dowRestricted = !isWildcard(fields[5])
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+func isWildcard(expr string) bool {
+	return expr == "*" || expr == "?"
+}
+
+// parseField returns the bitset of the values matched by the comma-separated list of the field
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		b, err := parseRange(part, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+// parseRange returns the bitset of the values matched by a single range expression, e.g. *, 5, 1-5, */10 or 1-30/5
+func parseRange(expr string, f field) (uint64, error) {
+	if expr == "" {
+		return 0, fmt.Errorf("empty list element")
+	}
+
+	rangeExpr, stepExpr, hasStep := strings.Cut(expr, "/")
+	var start, end uint
+	switch {
+	case isWildcard(rangeExpr):
+		start, end = f.min, f.max
+	default:
+		lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = parseValue(lowExpr, f); err != nil {
+			return 0, err
+		}
+		end = start
+		if isRange {
+			if end, err = parseValue(highExpr, f); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("range start %d is greater than range end %d", start, end)
+			}
+		} else if hasStep {
+			// a/n means from a to the max value with the step n
+			end = f.max
+		}
+	}
+
+	step := uint(1)
+	if hasStep {
+		n, err := strconv.ParseUint(stepExpr, 10, 8)
+		if err != nil || n == 0 {
+			return 0, fmt.Errorf("invalid step '%s'", stepExpr)
+		}
+		step = uint(n)
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+func parseValue(expr string, f field) (uint, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", expr)
+	}
+	v := uint(n)
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+	return v, nil
+}
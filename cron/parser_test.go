@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		expectedErr string
+	}{
+		{"5 fields", "*/5 * * * *", ""},
+		{"6 fields", "30 */5 * * * *", ""},
+		{"lists and ranges", "0 0,30 8-18 * * 1-5", ""},
+		{"range with step", "0 0-30/10 * * * *", ""},
+		{"value with step", "0 5/15 * * * *", ""},
+		{"names", "0 0 12 * JAN,jul MON-fri", ""},
+		{"question mark", "0 0 12 ? * MON", ""},
+		{"sunday as 7", "0 0 12 * * 7", ""},
+		{"yearly", "@yearly", ""},
+		{"annually", "@annually", ""},
+		{"monthly", "@monthly", ""},
+		{"weekly", "@weekly", ""},
+		{"daily", "@daily", ""},
+		{"midnight", "@midnight", ""},
+		{"hourly", "@hourly", ""},
+		{"every", "@every 1h30m", ""},
+		{"cron tz prefix", "CRON_TZ=America/New_York 0 9 * * *", ""},
+		{"tz prefix", "TZ=UTC @daily", ""},
+		{"surrounding spaces", "  0 9 * * *  ", ""},
+		{"empty", "", "must not be empty"},
+		{"blank", "   ", "must not be empty"},
+		{"too few fields", "*/5 * * *", "expected 5 or 6 fields, got 4"},
+		{"too many fields", "0 0 0 * * * 2026", "expected 5 or 6 fields, got 7"},
+		{"second out of range", "60 * * * * *", "invalid second field '60': value 60 out of range [0, 59]"},
+		{"minute out of range", "60 * * * *", "invalid minute field '60': value 60 out of range [0, 59]"},
+		{"hour out of range", "0 24 * * *", "invalid hour field '24': value 24 out of range [0, 23]"},
+		{"day of month zero", "0 0 0 * *", "invalid day-of-month field '0': value 0 out of range [1, 31]"},
+		{"month out of range", "0 0 1 13 *", "invalid month field '13': value 13 out of range [1, 12]"},
+		{"day of week out of range", "0 0 * * 8", "invalid day-of-week field '8': value 8 out of range [0, 7]"},
+		{"invalid name", "0 0 * FOO *", "invalid month field 'FOO': invalid value 'FOO'"},
+		{"reversed range", "0 10-5 * * *", "invalid hour field '10-5': range start 10 is greater than range end 5"},
+		{"zero step", "*/0 * * * *", "invalid minute field '*/0': invalid step '0'"},
+		{"invalid step", "*/x * * * *", "invalid minute field '*/x': invalid step 'x'"},
+		{"empty list element", "0,,5 * * * *", "invalid minute field '0,,5': empty list element"},
+		{"negative value", "-1 * * * *", "invalid minute field '-1'"},
+		{"unknown macro", "@fortnightly", "unknown macro '@fortnightly'"},
+		{"every without duration", "@every", "invalid @every duration ''"},
+		{"every invalid duration", "@every 5x", "invalid @every duration '5x'"},
+		{"every too short", "@every 500ms", "@every duration must be at least 1s"},
+		{"unknown time zone", "CRON_TZ=Mars/Olympus 0 9 * * *", "unknown time zone 'Mars/Olympus'"},
+		{"time zone without expression", "CRON_TZ=UTC", "missing the expression after the time zone"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				assert.NotNil(t, schedule)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
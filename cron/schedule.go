@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"time"
+)
+
+// maxSearchYears bounds the search of the next fire time, e.g. for an expression like "0 0 30 2 *" which never fires
+const maxSearchYears = 5
+
+// Schedule computes the fire times of a parsed cron expression
+type Schedule interface {
+	// Next returns the first fire time strictly after t, or the zero time if the schedule never fires
+	Next(t time.Time) time.Time
+}
+
+// specSchedule is the Schedule of a field-based cron expression, where each field is a bitset of the matched values
+type specSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+	// domRestricted and dowRestricted record whether the day fields were specified other than * or ?. When both day
+	// fields are restricted, a day matches if either field matches, following the traditional cron behavior.
+	domRestricted, dowRestricted bool
+	location                     *time.Location
+}
+
+// everySchedule is the Schedule of the @every macro
+type everySchedule struct {
+	interval time.Duration
+}
+
+// Next returns the time after t rounded down to the second plus the interval
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval - time.Duration(t.Nanosecond()))
+}
+
+// Next returns the first time strictly after t matching all fields. The time is evaluated in the location of the
+// schedule, which defaults to the location of t, and is returned in the location of t.
+func (s *specSchedule) Next(t time.Time) time.Time {
+	origLocation := t.Location()
+	location := s.location
+	if location == time.Local {
+		location = origLocation
+	}
+	t = t.In(location)
+
+	// start at the next whole second
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + maxSearchYears
+
+	// truncated records whether the smaller units have been reset after advancing a larger unit
+	truncated := false
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for s.month&(1<<uint(t.Month())) == 0 {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, location)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+		}
+		t = t.AddDate(0, 0, 1)
+		// the DST transition may shift the midnight, so move back to the start of the day
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for s.hour&(1<<uint(t.Hour())) == 0 {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, location)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for s.minute&(1<<uint(t.Minute())) == 0 {
+		if !truncated {
+			truncated = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for s.second&(1<<uint(t.Second())) == 0 {
+		if !truncated {
+			truncated = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t.In(origLocation)
+}
+
+// dayMatches checks the day-of-month and day-of-week fields. If only one of them is restricted, the other one
+// matches every day; if both are restricted, either of them should match.
+func (s *specSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	from := time.Date(2026, time.March, 14, 10, 17, 42, 500, time.UTC)
+
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"every minute", "* * * * *", from, time.Date(2026, time.March, 14, 10, 18, 0, 0, time.UTC)},
+		{"every 5 minutes", "*/5 * * * *", from, time.Date(2026, time.March, 14, 10, 20, 0, 0, time.UTC)},
+		{"every second", "* * * * * *", from, time.Date(2026, time.March, 14, 10, 17, 43, 0, time.UTC)},
+		{"strictly after", "0 18 10 * * *", time.Date(2026, time.March, 14, 10, 18, 0, 0, time.UTC),
+			time.Date(2026, time.March, 15, 10, 18, 0, 0, time.UTC)},
+		{"next hour", "0 9 * * *", from, time.Date(2026, time.March, 15, 9, 0, 0, 0, time.UTC)},
+		{"range with step", "0 0-30/10 * * * *", from, time.Date(2026, time.March, 14, 10, 20, 0, 0, time.UTC)},
+		{"value with step", "0 5/15 * * * *", from, time.Date(2026, time.March, 14, 10, 20, 0, 0, time.UTC)},
+		{"weekday", "0 9 * * MON", from, time.Date(2026, time.March, 16, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 9 * * 7", from, time.Date(2026, time.March, 15, 9, 0, 0, 0, time.UTC)},
+		{"day of month", "0 0 1 * *", from, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 20 * FRI", from, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week, dow first", "0 0 25 * MON", from, time.Date(2026, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"month", "0 0 1 JUL *", from, time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"next year", "0 0 1 1 *", from, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", from, time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"never", "0 0 30 2 *", from, time.Time{}},
+		{"yearly", "@yearly", from, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"monthly", "@monthly", from, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"weekly", "@weekly", from, time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"daily", "@daily", from, time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"hourly", "@hourly", from, time.Date(2026, time.March, 14, 11, 0, 0, 0, time.UTC)},
+		{"every", "@every 90s", from, time.Date(2026, time.March, 14, 10, 19, 12, 0, time.UTC)},
+		{"time zone", "CRON_TZ=Asia/Taipei 0 9 * * *", from, time.Date(2026, time.March, 15, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			next := schedule.Next(tt.from)
+			assert.True(t, tt.expected.Equal(next), "expected %v, got %v", tt.expected, next)
+			if !next.IsZero() {
+				assert.Equal(t, tt.from.Location(), next.Location())
+			}
+		})
+	}
+}
+
+func TestSchedule_NextDaylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		// 2026-03-08 02:00 doesn't exist in New York, the clock jumps to 03:00
+		{"spring forward skips the missing hour", "0 30 2 * * *", time.Date(2026, time.March, 7, 3, 0, 0, 0, newYork),
+			time.Date(2026, time.March, 9, 2, 30, 0, 0, newYork)},
+		{"spring forward keeps the daily midnight", "@daily", time.Date(2026, time.March, 7, 12, 0, 0, 0, newYork),
+			time.Date(2026, time.March, 8, 0, 0, 0, 0, newYork)},
+		{"fall back fires once", "0 30 1 * * *", time.Date(2026, time.October, 31, 3, 0, 0, 0, newYork),
+			time.Date(2026, time.November, 1, 1, 30, 0, 0, newYork)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			next := schedule.Next(tt.from)
+			assert.True(t, tt.expected.Equal(next), "expected %v, got %v", tt.expected, next)
+		})
+	}
+}
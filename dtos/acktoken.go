@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ackTokenSeparator separates the encoded claims and the signature of the ack token
+const ackTokenSeparator = "."
+
+// AckTokenClaims are the claims carried by the ack token, which authorizes the Recipient to acknowledge the
+// notification until the Expires timestamp in milliseconds
+type AckTokenClaims struct {
+	NotificationId string `json:"n"`
+	Recipient      string `json:"r"`
+	Expires        int64  `json:"e"`
+}
+
+// GenerateAckToken generates the ack token of the notification for the recipient, which is valid until the expiry
+// time. The token is in the format of <claims>.<signature>, where both parts are unpadded base64url encoded and the
+// signature is the HMAC-SHA256 of the encoded claims, so the token can be used in a URL path without escaping.
+func GenerateAckToken(secret []byte, notificationId, recipient string, expires time.Time) (string, errors.EdgeX) {
+	if len(secret) == 0 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the secret of the ack token must not be empty", nil)
+	}
+	if notificationId == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the notification id of the ack token must not be empty", nil)
+	}
+	b, err := json.Marshal(AckTokenClaims{NotificationId: notificationId, Recipient: recipient, Expires: expires.UnixMilli()})
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the ack token claims", err)
+	}
+	claims := base64.RawURLEncoding.EncodeToString(b)
+	return claims + ackTokenSeparator + base64.RawURLEncoding.EncodeToString(signAckToken(secret, claims)), nil
+}
+
+// VerifyAckToken verifies the signature and the expiry of the ack token, and returns its claims. The error kind is
+// ContractInvalid if the token is malformed, or Unauthorized if the signature doesn't match or the token expires.
+func VerifyAckToken(secret []byte, token string, now time.Time) (AckTokenClaims, errors.EdgeX) {
+	claimsPart, signaturePart, ok := strings.Cut(token, ackTokenSeparator)
+	if !ok {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "malformed ack token", nil)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(signaturePart)
+	if err != nil {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "malformed ack token signature", err)
+	}
+	if len(secret) == 0 || !hmac.Equal(signAckToken(secret, claimsPart), signature) {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindUnauthorized, "invalid ack token signature", nil)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(claimsPart)
+	if err != nil {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "malformed ack token claims", err)
+	}
+	var claims AckTokenClaims
+	if err = json.Unmarshal(b, &claims); err != nil {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "malformed ack token claims", err)
+	}
+	if now.UnixMilli() >= claims.Expires {
+		return AckTokenClaims{}, errors.NewCommonEdgeX(errors.KindUnauthorized,
+			fmt.Sprintf("the ack token of notification %s expired at %s", claims.NotificationId,
+				time.UnixMilli(claims.Expires).UTC().Format(time.RFC3339)), nil)
+	}
+	return claims, nil
+}
+
+func signAckToken(secret []byte, claims string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(claims))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const testAckNotificationId = "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+
+func TestGenerateAndVerifyAckToken(t *testing.T) {
+	secret := []byte("ack-secret")
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(24 * time.Hour)
+	recipient := "engineer@example.com"
+
+	token, err := GenerateAckToken(secret, testAckNotificationId, recipient, expires)
+	require.NoError(t, err)
+	assert.Equal(t, url.PathEscape(token), token, "ack token should be URL path safe")
+
+	claims, err := VerifyAckToken(secret, token, now)
+	require.NoError(t, err)
+	assert.Equal(t, AckTokenClaims{NotificationId: testAckNotificationId, Recipient: recipient, Expires: expires.UnixMilli()}, claims)
+
+	otherRecipient, err := GenerateAckToken(secret, testAckNotificationId, "manager@example.com", expires)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, otherRecipient)
+
+	claimsPart, signaturePart, _ := strings.Cut(token, ".")
+	forgedClaimsPart, _, _ := strings.Cut(otherRecipient, ".")
+
+	tests := []struct {
+		name         string
+		secret       []byte
+		token        string
+		now          time.Time
+		expectedKind errors.ErrKind
+	}{
+		{"expired", secret, token, expires, errors.KindUnauthorized},
+		{"wrong secret", []byte("other-secret"), token, now, errors.KindUnauthorized},
+		{"empty secret", nil, token, now, errors.KindUnauthorized},
+		{"forged claims", secret, forgedClaimsPart + "." + signaturePart, now, errors.KindUnauthorized},
+		{"no separator", secret, claimsPart, now, errors.KindContractInvalid},
+		{"malformed signature", secret, claimsPart + ".!!", now, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyAckToken(tt.secret, tt.token, tt.now)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+		})
+	}
+}
+
+func TestGenerateAckToken_Invalid(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	_, err := GenerateAckToken(nil, testAckNotificationId, "engineer@example.com", expires)
+	assert.Error(t, err)
+	_, err = GenerateAckToken([]byte("ack-secret"), "", "engineer@example.com", expires)
+	assert.Error(t, err)
+}
+
+func TestNotificationTemplate_RenderWithAckToken(t *testing.T) {
+	tmpl := NotificationTemplate{
+		Name:      testNotificationTemplateName,
+		EmailBody: "{{ .Content }}\nAcknowledge: https://edgex.example.com/notifications/acknowledge?token={{ ackToken }}",
+	}
+	require.NoError(t, tmpl.Validate())
+	notification := Notification{Id: testAckNotificationId, Content: "pump-1 is offline"}
+
+	rendered, err := tmpl.RenderWithAckToken(common.EMAIL, notification, "claims.signature")
+	require.NoError(t, err)
+	assert.Equal(t, "pump-1 is offline\nAcknowledge: https://edgex.example.com/notifications/acknowledge?token=claims.signature", rendered.Content)
+
+	_, err = tmpl.Render(common.EMAIL, notification)
+	require.Error(t, err, "the template referring to the ack token should not be rendered without ack token")
+	_, err = tmpl.RenderWithAckToken(common.EMAIL, notification, "")
+	require.Error(t, err)
+
+	// the template without ack token is rendered as usual
+	tmpl.EmailBody = "{{ .Content }}"
+	rendered, err = tmpl.Render(common.EMAIL, notification)
+	require.NoError(t, err)
+	assert.Equal(t, "pump-1 is offline", rendered.Content)
+}
+
+func TestRenderWebhookBodyWithAckToken(t *testing.T) {
+	bodyTemplate := `{"text":{{ json .Content }},"ackToken":"{{ ackToken }}"}`
+	_, err := ParseWebhookBodyTemplate(bodyTemplate)
+	require.NoError(t, err)
+	notification := Notification{Id: testAckNotificationId, Content: "pump-1 is offline"}
+
+	body, err := RenderWebhookBodyWithAckToken(bodyTemplate, notification, "claims.signature")
+	require.NoError(t, err)
+	assert.Equal(t, `{"text":"pump-1 is offline","ackToken":"claims.signature"}`, string(body))
+
+	_, err = RenderWebhookBody(bodyTemplate, notification)
+	require.Error(t, err, "the body template referring to the ack token should not be rendered without ack token")
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2024 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,16 +12,23 @@ import (
 )
 
 type Address struct {
-	Type string `json:"type" validate:"oneof='REST' 'MQTT' 'EMAIL' 'ZeroMQ'"`
+	Type string `json:"type" validate:"oneof='REST' 'MQTT' 'EMAIL' 'ZeroMQ' 'WEBHOOK' 'KAFKA' 'NATS'"`
 
 	Scheme string `json:"scheme,omitempty"`
-	Host   string `json:"host,omitempty" validate:"required_unless=Type EMAIL"`
-	Port   int    `json:"port,omitempty" validate:"required_unless=Type EMAIL"`
+	Host   string `json:"host,omitempty" validate:"required_unless=Type EMAIL Type KAFKA Type NATS"`
+	Port   int    `json:"port,omitempty" validate:"required_unless=Type EMAIL Type KAFKA Type NATS"`
+
+	// MinSeverity is the minimum Severity of the notifications delivered to the channel, the default is all
+	MinSeverity string `json:"minSeverity,omitempty" validate:"omitempty,oneof='MINOR' 'NORMAL' 'CRITICAL'"`
 
 	RESTAddress    `json:",inline" validate:"-"`
 	MQTTPubAddress `json:",inline" validate:"-"`
 	EmailAddress   `json:",inline" validate:"-"`
 	ZeroMQAddress  `json:",inline" validate:"-"`
+	WebhookAddress `json:",inline" validate:"-"`
+	KafkaAddress   `json:",inline" validate:"-"`
+	NATSAddress    `json:",inline" validate:"-"`
+	MessageBrokers `json:",inline" validate:"-"`
 	MessageBus     `json:",inline" validate:"-"`
 	Security       `json:",inline" validate:"-"`
 }
@@ -57,6 +64,64 @@ func (a *Address) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid EmailAddress.", err)
 		}
+	case common.WEBHOOK:
+		err = common.Validate(a.RESTAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress.", err)
+		}
+		err = common.Validate(a.WebhookAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress.", err)
+		}
+		// only parse the template, since executing it with a sample notification fails on e.g. {{ index .Labels 0 }}
+		if a.BodyTemplate != "" {
+			if _, err = ParseWebhookBodyTemplate(a.BodyTemplate); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress.BodyTemplate.", err)
+			}
+		}
+		if a.SignatureHeader != "" && a.SecretPath == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress, the secretPath is required to sign the request.", nil)
+		}
+	case common.KAFKA:
+		err = common.Validate(a.MessageBrokers)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KafkaAddress.", err)
+		}
+		err = common.Validate(a.MessageBus)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KafkaAddress.", err)
+		}
+		if err = a.validateBrokerOptions(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KafkaAddress.", err)
+		}
+	case common.NATS:
+		err = common.Validate(a.MessageBrokers)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NATSAddress.", err)
+		}
+		err = common.Validate(a.NATSAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NATSAddress.", err)
+		}
+		if err = a.validateBrokerOptions(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NATSAddress.", err)
+		}
+	}
+
+	return nil
+}
+
+// validateBrokerOptions validates the headers and the optional security of the KAFKA and NATS addresses
+func (a *Address) validateBrokerOptions() error {
+	for name := range a.Headers {
+		if name == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the header name must not be empty", nil)
+		}
+	}
+	if a.Security != (Security{}) {
+		if err := common.Validate(a.Security); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
 
 	return nil
@@ -148,6 +213,71 @@ type Security struct {
 type ZeroMQAddress struct {
 }
 
+// WebhookAddress contains the WEBHOOK specific fields. The path and httpMethod are shared with the RESTAddress, and
+// the secretPath with the Security.
+type WebhookAddress struct {
+	Headers         map[string]string `json:"headers,omitempty" validate:"omitempty,dive,keys,required,endkeys"`
+	BodyTemplate    string            `json:"bodyTemplate,omitempty"`
+	SignatureHeader string            `json:"signatureHeader,omitempty"`
+}
+
+func NewWebhookAddress(scheme string, host string, port int, path string, httpMethod string, bodyTemplate string, secretPath string) Address {
+	if scheme == "" {
+		scheme = common.HTTP
+	}
+	return Address{
+		Type:   common.WEBHOOK,
+		Scheme: scheme,
+		Host:   host,
+		Port:   port,
+		RESTAddress: RESTAddress{
+			Path:       path,
+			HTTPMethod: httpMethod,
+		},
+		WebhookAddress: WebhookAddress{
+			BodyTemplate: bodyTemplate,
+		},
+		Security: Security{
+			SecretPath: secretPath,
+		},
+	}
+}
+
+// MessageBrokers contains the broker addresses shared by the KAFKA and NATS addresses
+type MessageBrokers struct {
+	Brokers []string `json:"brokers,omitempty" validate:"gt=0,dive,edgex-dto-none-empty-string"`
+}
+
+// KafkaAddress contains the KAFKA specific fields. The topic is shared with the MessageBus, the headers with the
+// WebhookAddress, and the TLS settings with the Security.
+type KafkaAddress struct {
+	ClientId     string `json:"clientId,omitempty"`
+	PartitionKey string `json:"partitionKey,omitempty"`
+}
+
+func NewKafkaAddress(brokers []string, topic string) Address {
+	return Address{
+		Type:           common.KAFKA,
+		MessageBrokers: MessageBrokers{Brokers: brokers},
+		MessageBus:     MessageBus{Topic: topic},
+	}
+}
+
+// NATSAddress contains the NATS specific fields. The headers are shared with the WebhookAddress, and the TLS settings
+// with the Security.
+type NATSAddress struct {
+	Subject   string `json:"subject,omitempty" validate:"required"`
+	JetStream bool   `json:"jetStream,omitempty"`
+}
+
+func NewNATSAddress(brokers []string, subject string) Address {
+	return Address{
+		Type:           common.NATS,
+		MessageBrokers: MessageBrokers{Brokers: brokers},
+		NATSAddress:    NATSAddress{Subject: subject},
+	}
+}
+
 func NewZeroMQAddress(host string, port int, topic string) Address {
 	return Address{
 		Type:       common.ZeroMQ,
@@ -164,7 +294,7 @@ func ToAddressModel(a Address) models.Address {
 	case common.REST:
 		address = models.RESTAddress{
 			BaseAddress: models.BaseAddress{
-				Type: a.Type, Host: a.Host, Port: a.Port, Scheme: a.Scheme,
+				Type: a.Type, Host: a.Host, Port: a.Port, Scheme: a.Scheme, MinSeverity: models.NotificationSeverity(a.MinSeverity),
 			},
 			Path:            a.Path,
 			HTTPMethod:      a.HTTPMethod,
@@ -173,7 +303,7 @@ func ToAddressModel(a Address) models.Address {
 	case common.MQTT:
 		address = models.MQTTPubAddress{
 			BaseAddress: models.BaseAddress{
-				Type: a.Type, Scheme: a.Scheme, Host: a.Host, Port: a.Port,
+				Type: a.Type, Scheme: a.Scheme, Host: a.Host, Port: a.Port, MinSeverity: models.NotificationSeverity(a.MinSeverity),
 			},
 			Security: models.Security{
 				SecretPath:     a.SecretPath,
@@ -191,17 +321,60 @@ func ToAddressModel(a Address) models.Address {
 	case common.ZeroMQ:
 		address = models.ZeroMQAddress{
 			BaseAddress: models.BaseAddress{
-				Type: a.Type, Host: a.Host, Port: a.Port,
+				Type: a.Type, Host: a.Host, Port: a.Port, MinSeverity: models.NotificationSeverity(a.MinSeverity),
 			},
 			MessageBus: models.MessageBus{Topic: a.Topic},
 		}
 	case common.EMAIL:
 		address = models.EmailAddress{
 			BaseAddress: models.BaseAddress{
-				Type: a.Type,
+				Type: a.Type, MinSeverity: models.NotificationSeverity(a.MinSeverity),
 			},
 			Recipients: a.Recipients,
 		}
+	case common.WEBHOOK:
+		address = models.WebhookAddress{
+			BaseAddress: models.BaseAddress{
+				Type: a.Type, Scheme: a.Scheme, Host: a.Host, Port: a.Port, MinSeverity: models.NotificationSeverity(a.MinSeverity),
+			},
+			Path:            a.Path,
+			HTTPMethod:      a.HTTPMethod,
+			Headers:         a.Headers,
+			BodyTemplate:    a.BodyTemplate,
+			SignatureHeader: a.SignatureHeader,
+			SecretPath:      a.SecretPath,
+		}
+	case common.KAFKA:
+		address = models.KafkaAddress{
+			BaseAddress: models.BaseAddress{
+				Type: a.Type, MinSeverity: models.NotificationSeverity(a.MinSeverity),
+			},
+			MessageBus: models.MessageBus{Topic: a.Topic},
+			Security: models.Security{
+				SecretPath:     a.SecretPath,
+				AuthMode:       a.AuthMode,
+				SkipCertVerify: a.SkipCertVerify,
+			},
+			Brokers:      a.Brokers,
+			Headers:      a.Headers,
+			ClientId:     a.ClientId,
+			PartitionKey: a.PartitionKey,
+		}
+	case common.NATS:
+		address = models.NATSAddress{
+			BaseAddress: models.BaseAddress{
+				Type: a.Type, MinSeverity: models.NotificationSeverity(a.MinSeverity),
+			},
+			Security: models.Security{
+				SecretPath:     a.SecretPath,
+				AuthMode:       a.AuthMode,
+				SkipCertVerify: a.SkipCertVerify,
+			},
+			Brokers:   a.Brokers,
+			Subject:   a.Subject,
+			Headers:   a.Headers,
+			JetStream: a.JetStream,
+		}
 	}
 	return address
 }
@@ -212,6 +385,8 @@ func FromAddressModelToDTO(address models.Address) Address {
 		Scheme: address.GetBaseAddress().Scheme,
 		Host:   address.GetBaseAddress().Host,
 		Port:   address.GetBaseAddress().Port,
+
+		MinSeverity: string(address.GetBaseAddress().MinSeverity),
 	}
 
 	switch a := address.(type) {
@@ -242,6 +417,44 @@ func FromAddressModelToDTO(address models.Address) Address {
 		dto.EmailAddress = EmailAddress{
 			Recipients: a.Recipients,
 		}
+	case models.WebhookAddress:
+		dto.RESTAddress = RESTAddress{
+			Path:       a.Path,
+			HTTPMethod: a.HTTPMethod,
+		}
+		dto.WebhookAddress = WebhookAddress{
+			Headers:         a.Headers,
+			BodyTemplate:    a.BodyTemplate,
+			SignatureHeader: a.SignatureHeader,
+		}
+		dto.Security = Security{
+			SecretPath: a.SecretPath,
+		}
+	case models.KafkaAddress:
+		dto.KafkaAddress = KafkaAddress{
+			ClientId:     a.ClientId,
+			PartitionKey: a.PartitionKey,
+		}
+		dto.MessageBrokers = MessageBrokers{Brokers: a.Brokers}
+		dto.MessageBus = MessageBus{Topic: a.Topic}
+		dto.WebhookAddress = WebhookAddress{Headers: a.Headers}
+		dto.Security = Security{
+			SecretPath:     a.SecretPath,
+			AuthMode:       a.AuthMode,
+			SkipCertVerify: a.SkipCertVerify,
+		}
+	case models.NATSAddress:
+		dto.NATSAddress = NATSAddress{
+			Subject:   a.Subject,
+			JetStream: a.JetStream,
+		}
+		dto.MessageBrokers = MessageBrokers{Brokers: a.Brokers}
+		dto.WebhookAddress = WebhookAddress{Headers: a.Headers}
+		dto.Security = Security{
+			SecretPath:     a.SecretPath,
+			AuthMode:       a.AuthMode,
+			SkipCertVerify: a.SkipCertVerify,
+		}
 	}
 	return dto
 }
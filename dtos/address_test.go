@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -26,6 +26,8 @@ const (
 	testPublisher  = "testPublisher"
 	testTopic      = "testTopic"
 	testEmail      = "test@example.com"
+	testBroker     = "localhost:9092"
+	testSubject    = "edgex.notifications"
 )
 
 var testRESTAddress = Address{
@@ -66,6 +68,40 @@ var testEmailAddress = Address{
 	},
 }
 
+var testWebhookAddress = Address{
+	Type:   common.WEBHOOK,
+	Scheme: "https",
+	Host:   testHost,
+	Port:   testPort,
+	RESTAddress: RESTAddress{
+		Path:       testPath,
+		HTTPMethod: http.MethodPost,
+	},
+	WebhookAddress: WebhookAddress{
+		Headers:         map[string]string{"Content-Type": common.ContentTypeJSON},
+		BodyTemplate:    `{"text":{{ json .Content }},"severity":"{{ .Severity }}"}`,
+		SignatureHeader: common.WebhookSignatureHeader,
+	},
+	Security: Security{SecretPath: "webhook"},
+}
+
+var testKafkaAddress = Address{
+	Type:           common.KAFKA,
+	MessageBrokers: MessageBrokers{Brokers: []string{testBroker, "localhost:9093"}},
+	MessageBus:     MessageBus{Topic: testTopic},
+	KafkaAddress:   KafkaAddress{ClientId: "edgex", PartitionKey: "notifications"},
+	WebhookAddress: WebhookAddress{Headers: map[string]string{"source": "edgex"}},
+	Security:       Security{SecretPath: "kafka", AuthMode: "clientcert"},
+}
+
+var testNATSAddress = Address{
+	Type:           common.NATS,
+	MessageBrokers: MessageBrokers{Brokers: []string{"nats://localhost:4222"}},
+	NATSAddress:    NATSAddress{Subject: testSubject, JetStream: true},
+	WebhookAddress: WebhookAddress{Headers: map[string]string{"source": "edgex"}},
+	Security:       Security{SecretPath: "nats", AuthMode: "usernamepassword"},
+}
+
 func TestAddress_UnmarshalJSON(t *testing.T) {
 	restJsonStr := fmt.Sprintf(
 		`{"type":"%s","host":"%s","port":%d,"path":"%s","httpMethod":"%s"}`,
@@ -83,6 +119,13 @@ func TestAddress_UnmarshalJSON(t *testing.T) {
 		testMQTTPubAddress.Publisher, testMQTTPubAddress.Topic,
 	)
 	emailJsonStr := fmt.Sprintf(`{"type":"%s","Recipients":["%s"]}`, testEmailAddress.Type, testEmail)
+	webhookJsonStr := fmt.Sprintf(
+		`{"type":"%s","scheme":"https","host":"%s","port":%d,"path":"%s","httpMethod":"POST","headers":{"Content-Type":"application/json"},"bodyTemplate":%q,"signatureHeader":"%s","secretPath":"webhook"}`,
+		testWebhookAddress.Type, testWebhookAddress.Host, testWebhookAddress.Port, testWebhookAddress.Path,
+		testWebhookAddress.BodyTemplate, testWebhookAddress.SignatureHeader,
+	)
+	kafkaJsonStr := `{"type":"KAFKA","brokers":["localhost:9092","localhost:9093"],"topic":"testTopic","clientId":"edgex","partitionKey":"notifications","headers":{"source":"edgex"},"secretPath":"kafka","authMode":"clientcert"}`
+	natsJsonStr := `{"type":"NATS","brokers":["nats://localhost:4222"],"subject":"edgex.notifications","jetStream":true,"headers":{"source":"edgex"},"secretPath":"nats","authMode":"usernamepassword"}`
 
 	tests := []struct {
 		name     string
@@ -94,6 +137,9 @@ func TestAddress_UnmarshalJSON(t *testing.T) {
 		{"unmarshal RESTAddressWithAuthInject with success", testRESTAddressWithAuthInject, []byte(restWithInjectJsonStr), false},
 		{"unmarshal MQTTPubAddress with success", testMQTTPubAddress, []byte(mqttJsonStr), false},
 		{"unmarshal EmailAddress with success", testEmailAddress, []byte(emailJsonStr), false},
+		{"unmarshal WebhookAddress with success", testWebhookAddress, []byte(webhookJsonStr), false},
+		{"unmarshal KafkaAddress with success", testKafkaAddress, []byte(kafkaJsonStr), false},
+		{"unmarshal NATSAddress with success", testNATSAddress, []byte(natsJsonStr), false},
 		{"unmarshal invalid Address, empty data", Address{}, []byte{}, true},
 		{"unmarshal invalid Address, string data", Address{}, []byte("Invalid address"), true},
 	}
@@ -129,6 +175,56 @@ func TestAddress_Validate(t *testing.T) {
 	invalidEmailAddress := testEmailAddress
 	invalidEmailAddress.Recipients = []string{"test.example.com"}
 
+	validWebhook := testWebhookAddress
+	validWebhookNoTemplate := testWebhookAddress
+	validWebhookNoTemplate.BodyTemplate = ""
+	validWebhookNoSignature := testWebhookAddress
+	validWebhookNoSignature.SignatureHeader = ""
+	validWebhookNoSignature.SecretPath = ""
+	noWebhookHttpMethod := testWebhookAddress
+	noWebhookHttpMethod.HTTPMethod = ""
+	noWebhookHost := testWebhookAddress
+	noWebhookHost.Host = ""
+	invalidWebhookTemplateSyntax := testWebhookAddress
+	invalidWebhookTemplateSyntax.BodyTemplate = `{"text": {{ .Content }`
+	validWebhookTemplateIndex := testWebhookAddress
+	validWebhookTemplateIndex.BodyTemplate = `{"label": "{{ index .Labels 0 }}"}`
+	invalidWebhookTemplateField := testWebhookAddress
+	invalidWebhookTemplateField.BodyTemplate = `{"text": "{{ .Message }}"}`
+	emptyWebhookHeaderName := testWebhookAddress
+	emptyWebhookHeaderName.Headers = map[string]string{"": "value"}
+	noWebhookSecretPath := testWebhookAddress
+	noWebhookSecretPath.SecretPath = ""
+
+	validKafka := testKafkaAddress
+	validKafkaNoSecurity := NewKafkaAddress([]string{testBroker}, testTopic)
+	noKafkaBrokers := testKafkaAddress
+	noKafkaBrokers.Brokers = nil
+	emptyKafkaBroker := testKafkaAddress
+	emptyKafkaBroker.Brokers = []string{" "}
+	noKafkaTopic := testKafkaAddress
+	noKafkaTopic.Topic = ""
+	emptyKafkaHeaderName := testKafkaAddress
+	emptyKafkaHeaderName.Headers = map[string]string{"": "value"}
+	noKafkaSecretPath := testKafkaAddress
+	noKafkaSecretPath.SecretPath = ""
+	invalidKafkaAuthMode := testKafkaAddress
+	invalidKafkaAuthMode.AuthMode = "token"
+
+	validNATS := testNATSAddress
+	validNATSNoSecurity := NewNATSAddress([]string{"nats://localhost:4222"}, testSubject)
+	noNATSBrokers := testNATSAddress
+	noNATSBrokers.Brokers = []string{}
+	noNATSSubject := testNATSAddress
+	noNATSSubject.Subject = ""
+	noNATSAuthMode := testNATSAddress
+	noNATSAuthMode.AuthMode = ""
+
+	validMinSeverity := testEmailAddress
+	validMinSeverity.MinSeverity = models.Critical
+	invalidMinSeverity := testRESTAddress
+	invalidMinSeverity.MinSeverity = "HIGH"
+
 	tests := []struct {
 		name        string
 		dto         Address
@@ -142,6 +238,31 @@ func TestAddress_Validate(t *testing.T) {
 		{"invalid MQTTPubAddress, no MQTT Topic", noMQTTTopic, true},
 		{"valid EmailAddress", validEmail, false},
 		{"invalid EmailAddress", invalidEmailAddress, true},
+		{"valid WebhookAddress", validWebhook, false},
+		{"valid WebhookAddress, no body template", validWebhookNoTemplate, false},
+		{"valid WebhookAddress, no signature", validWebhookNoSignature, false},
+		{"invalid WebhookAddress, no HTTP method", noWebhookHttpMethod, true},
+		{"invalid WebhookAddress, no host", noWebhookHost, true},
+		{"invalid WebhookAddress, template syntax error", invalidWebhookTemplateSyntax, true},
+		{"valid WebhookAddress, body template indexes the labels", validWebhookTemplateIndex, false},
+		{"invalid WebhookAddress, unknown template field", invalidWebhookTemplateField, true},
+		{"invalid WebhookAddress, empty header name", emptyWebhookHeaderName, true},
+		{"invalid WebhookAddress, signature header without secret path", noWebhookSecretPath, true},
+		{"valid KafkaAddress", validKafka, false},
+		{"valid KafkaAddress, no security", validKafkaNoSecurity, false},
+		{"invalid KafkaAddress, no brokers", noKafkaBrokers, true},
+		{"invalid KafkaAddress, empty broker", emptyKafkaBroker, true},
+		{"invalid KafkaAddress, no topic", noKafkaTopic, true},
+		{"invalid KafkaAddress, empty header name", emptyKafkaHeaderName, true},
+		{"invalid KafkaAddress, auth mode without secret path", noKafkaSecretPath, true},
+		{"invalid KafkaAddress, unknown auth mode", invalidKafkaAuthMode, true},
+		{"valid NATSAddress", validNATS, false},
+		{"valid NATSAddress, no security", validNATSNoSecurity, false},
+		{"invalid NATSAddress, no brokers", noNATSBrokers, true},
+		{"invalid NATSAddress, no subject", noNATSSubject, true},
+		{"invalid NATSAddress, secret path without auth mode", noNATSAuthMode, true},
+		{"valid, minimum severity", validMinSeverity, false},
+		{"invalid, unknown minimum severity", invalidMinSeverity, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -170,6 +291,53 @@ func TestEmailAddressDTOtoModel(t *testing.T) {
 	assert.Equal(t, recipients, m.(models.EmailAddress).Recipients)
 }
 
+func TestWebhookAddressModelAndDTOConversion(t *testing.T) {
+	model := ToAddressModel(testWebhookAddress)
+	require.IsType(t, models.WebhookAddress{}, model)
+	webhook := model.(models.WebhookAddress)
+	assert.Equal(t, testPath, webhook.Path)
+	assert.Equal(t, http.MethodPost, webhook.HTTPMethod)
+	assert.Equal(t, testWebhookAddress.Headers, webhook.Headers)
+	assert.Equal(t, testWebhookAddress.BodyTemplate, webhook.BodyTemplate)
+	assert.Equal(t, common.WebhookSignatureHeader, webhook.SignatureHeader)
+	assert.Equal(t, "webhook", webhook.SecretPath)
+
+	assert.Equal(t, testWebhookAddress, FromAddressModelToDTO(model))
+}
+
+func TestBrokerAddressModelAndDTOConversion(t *testing.T) {
+	model := ToAddressModel(testKafkaAddress)
+	require.IsType(t, models.KafkaAddress{}, model)
+	kafka := model.(models.KafkaAddress)
+	assert.Equal(t, testKafkaAddress.Brokers, kafka.Brokers)
+	assert.Equal(t, testTopic, kafka.Topic)
+	assert.Equal(t, testKafkaAddress.Headers, kafka.Headers)
+	assert.Equal(t, "clientcert", kafka.AuthMode)
+	assert.Equal(t, testKafkaAddress, FromAddressModelToDTO(model))
+
+	model = ToAddressModel(testNATSAddress)
+	require.IsType(t, models.NATSAddress{}, model)
+	nats := model.(models.NATSAddress)
+	assert.Equal(t, testNATSAddress.Brokers, nats.Brokers)
+	assert.Equal(t, testSubject, nats.Subject)
+	assert.True(t, nats.JetStream)
+	assert.Equal(t, "nats", nats.SecretPath)
+	assert.Equal(t, testNATSAddress, FromAddressModelToDTO(model))
+}
+
+func TestAddressMinSeverityConversion(t *testing.T) {
+	addresses := []Address{testRESTAddress, testMQTTPubAddress, testEmailAddress, NewZeroMQAddress(testHost, testPort, testTopic), testWebhookAddress,
+		testKafkaAddress, testNATSAddress}
+	for _, a := range addresses {
+		t.Run(a.Type, func(t *testing.T) {
+			a.MinSeverity = models.Normal
+			model := ToAddressModel(a)
+			assert.Equal(t, models.NotificationSeverity(models.Normal), model.GetBaseAddress().MinSeverity)
+			assert.Equal(t, models.Normal, FromAddressModelToDTO(model).MinSeverity)
+		})
+	}
+}
+
 func TestAddress_marshalJSON(t *testing.T) {
 	restAddress := Address{
 		Type: common.REST,
@@ -224,6 +392,8 @@ func TestAddress_marshalJSON(t *testing.T) {
 		{"marshal REST address with auth inject", restAddressWithAuthInject, expectedRESTWithAuthInjectJsonStr},
 		{"marshal MQTT address", mattAddress, expectedMQTTJsonStr},
 		{"marshal Email address", emailAddress, expectedEmailJsonStr},
+		{"marshal Kafka address", NewKafkaAddress([]string{testBroker}, testTopic), `{"type":"KAFKA","brokers":["localhost:9092"],"topic":"testTopic"}`},
+		{"marshal NATS address", NewNATSAddress([]string{"nats://localhost:4222"}, testSubject), `{"type":"NATS","subject":"edgex.notifications","brokers":["nats://localhost:4222"]}`},
 	}
 
 	for _, tt := range tests {
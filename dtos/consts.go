@@ -19,6 +19,8 @@ const (
 	keySourceName = "sourceName"
 	keyReadings   = "readings"
 
+	keyOptimizedPayload = "optimizedPayload"
+
 	// BaseReading-only keys
 	keyResourceName = "resourceName"
 	keyValueType    = "valueType"
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// digestNoCategory is shown in the digest content for the notifications without category
+const digestNoCategory = "uncategorized"
+
+// DigestGroup contains the notifications of the same category and severity in a digest
+type DigestGroup struct {
+	Category      string         `json:"category,omitempty"`
+	Severity      string         `json:"severity"`
+	Notifications []Notification `json:"notifications"`
+}
+
+// GroupDigestNotifications groups the notifications by category and severity. The groups are ordered from the most
+// severe, then by category, and the notifications of each group are ordered by the creation time.
+func GroupDigestNotifications(notifications []Notification) []DigestGroup {
+	var groups []DigestGroup
+	index := make(map[[2]string]int)
+	for _, n := range notifications {
+		key := [2]string{n.Category, n.Severity}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, DigestGroup{Category: n.Category, Severity: n.Severity})
+		}
+		groups[i].Notifications = append(groups[i].Notifications, n)
+	}
+
+	slices.SortFunc(groups, func(a, b DigestGroup) int {
+		return cmp.Or(
+			cmp.Compare(digestSeverityRank(a.Severity), digestSeverityRank(b.Severity)),
+			cmp.Compare(a.Severity, b.Severity),
+			cmp.Compare(a.Category, b.Category),
+		)
+	})
+	for _, g := range groups {
+		slices.SortStableFunc(g.Notifications, func(a, b Notification) int {
+			return cmp.Compare(a.Created, b.Created)
+		})
+	}
+	return groups
+}
+
+// BuildDigestContent builds the plain text content of the digest of the notifications, which lists the groups of
+// GroupDigestNotifications with their counts. At most maxItems notifications are listed, or all of them if maxItems
+// is 0, and the rest are only counted. The empty string is returned if there are no notifications.
+func BuildDigestContent(notifications []Notification, maxItems int) string {
+	if len(notifications) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Digest of %d notifications\n", len(notifications))
+	listed := 0
+	for _, g := range GroupDigestNotifications(notifications) {
+		category := g.Category
+		if category == "" {
+			category = digestNoCategory
+		}
+		fmt.Fprintf(&sb, "\n[%s] %s (%d)\n", g.Severity, category, len(g.Notifications))
+		for _, n := range g.Notifications {
+			if maxItems > 0 && listed >= maxItems {
+				break
+			}
+			// collapse the whitespaces so that each notification takes one line
+			fmt.Fprintf(&sb, "- %s: %s\n", n.Sender, strings.Join(strings.Fields(n.Content), " "))
+			listed++
+		}
+	}
+	if listed < len(notifications) {
+		fmt.Fprintf(&sb, "\n... and %d more notifications\n", len(notifications)-listed)
+	}
+	return sb.String()
+}
+
+// digestSeverityRank orders the severities from the most severe, and the unknown severities come last
+func digestSeverityRank(severity string) int {
+	switch severity {
+	case models.Critical:
+		return 0
+	case models.Normal:
+		return 1
+	case models.Minor:
+		return 2
+	default:
+		return 3
+	}
+}
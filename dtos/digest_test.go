@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func digestNotificationsData() []Notification {
+	notification := func(category, severity, sender, content string, created int64) Notification {
+		n := NewNotification(nil, category, content, sender, severity)
+		n.Created = created
+		return n
+	}
+	return []Notification{
+		notification("temperature", models.Normal, "device-a", "Temperature is 35°C", 3),
+		notification("disk", models.Critical, "device-b", "Disk is full", 2),
+		notification("temperature", models.Normal, "device-c", "Temperature is\n36°C", 1),
+		notification("", models.Minor, "device-a", "Heartbeat missed", 4),
+		notification("battery", models.Critical, "device-d", "Battery is low", 5),
+	}
+}
+
+func TestGroupDigestNotifications(t *testing.T) {
+	groups := GroupDigestNotifications(digestNotificationsData())
+
+	require.Len(t, groups, 4)
+	expected := []struct {
+		category string
+		severity string
+		senders  []string
+	}{
+		{"battery", models.Critical, []string{"device-d"}},
+		{"disk", models.Critical, []string{"device-b"}},
+		{"temperature", models.Normal, []string{"device-c", "device-a"}},
+		{"", models.Minor, []string{"device-a"}},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.category, groups[i].Category)
+		assert.Equal(t, e.severity, groups[i].Severity)
+		var senders []string
+		for _, n := range groups[i].Notifications {
+			senders = append(senders, n.Sender)
+		}
+		assert.Equal(t, e.senders, senders)
+	}
+	assert.Empty(t, GroupDigestNotifications(nil))
+}
+
+func TestBuildDigestContent(t *testing.T) {
+	notifications := digestNotificationsData()
+
+	tests := []struct {
+		name     string
+		maxItems int
+		expected string
+	}{
+		{"list all notifications", 0, `Digest of 5 notifications
+
+[CRITICAL] battery (1)
+- device-d: Battery is low
+
+[CRITICAL] disk (1)
+- device-b: Disk is full
+
+[NORMAL] temperature (2)
+- device-c: Temperature is 36°C
+- device-a: Temperature is 35°C
+
+[MINOR] uncategorized (1)
+- device-a: Heartbeat missed
+`},
+		{"list the most severe notifications up to max items", 3, `Digest of 5 notifications
+
+[CRITICAL] battery (1)
+- device-d: Battery is low
+
+[CRITICAL] disk (1)
+- device-b: Disk is full
+
+[NORMAL] temperature (2)
+- device-c: Temperature is 36°C
+
+[MINOR] uncategorized (1)
+
+... and 2 more notifications
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, BuildDigestContent(notifications, tt.maxItems))
+		})
+	}
+	assert.Empty(t, BuildDigestContent(nil, 10))
+}
+
+func TestDigestConversion(t *testing.T) {
+	dto := Subscription{Name: "digest", Digest: &Digest{Interval: "1h", MaxItems: 50}}
+	model := ToSubscriptionModel(dto)
+	assert.Equal(t, &models.Digest{Interval: "1h", MaxItems: 50}, model.Digest)
+	assert.Equal(t, dto.Digest, FromSubscriptionModelToDTO(model).Digest)
+	assert.Nil(t, ToSubscriptionModel(Subscription{Name: "immediate"}).Digest)
+}
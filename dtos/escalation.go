@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// EscalationPolicy escalates the unacknowledged notifications of a Subscription level by level
+type EscalationPolicy struct {
+	Levels []EscalationLevel `json:"levels" validate:"required,gt=0,dive"`
+}
+
+// EscalationLevel escalates to the subscription named SubscriptionName or to the Channels when the transmission
+// fails AfterFailures times, or isn't acknowledged within the AckTimeout, since the previous level is escalated or
+// since the transmission is created for the first level.
+type EscalationLevel struct {
+	AfterFailures    int       `json:"afterFailures,omitempty" validate:"gte=0"`
+	AckTimeout       string    `json:"ackTimeout,omitempty" validate:"omitempty,edgex-dto-duration"`
+	SubscriptionName string    `json:"subscriptionName,omitempty" validate:"omitempty,edgex-dto-none-empty-string"`
+	Channels         []Address `json:"channels,omitempty" validate:"omitempty,dive"`
+}
+
+// IsEmpty returns true if the policy has no level, which removes the policy in UpdateSubscription
+func (p EscalationPolicy) IsEmpty() bool {
+	return len(p.Levels) == 0
+}
+
+// Validate satisfies the Validator interface. Each level should have at least one trigger, the AfterFailures or the
+// AckTimeout, and exactly one target, the SubscriptionName or the Channels.
+func (p *EscalationPolicy) Validate() error {
+	err := common.Validate(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid EscalationPolicy.", err)
+	}
+	for i, level := range p.Levels {
+		if level.AfterFailures == 0 && level.AckTimeout == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("escalation level %d should specify afterFailures or ackTimeout", i), nil)
+		}
+		if (level.SubscriptionName == "") == (len(level.Channels) == 0) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("escalation level %d should specify either subscriptionName or channels", i), nil)
+		}
+		for _, c := range level.Channels {
+			if err = c.Validate(); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid channel of escalation level %d", i), err)
+			}
+		}
+	}
+	return nil
+}
+
+// ToEscalationPolicyModel transforms the EscalationPolicy DTO to the EscalationPolicy Model
+func ToEscalationPolicyModel(p EscalationPolicy) models.EscalationPolicy {
+	levels := make([]models.EscalationLevel, len(p.Levels))
+	for i, level := range p.Levels {
+		levels[i] = models.EscalationLevel{
+			AfterFailures:    level.AfterFailures,
+			AckTimeout:       level.AckTimeout,
+			SubscriptionName: level.SubscriptionName,
+		}
+		if level.Channels != nil {
+			levels[i].Channels = ToAddressModels(level.Channels)
+		}
+	}
+	return models.EscalationPolicy{Levels: levels}
+}
+
+// FromEscalationPolicyModelToDTO transforms the EscalationPolicy Model to the EscalationPolicy DTO
+func FromEscalationPolicyModelToDTO(p models.EscalationPolicy) EscalationPolicy {
+	levels := make([]EscalationLevel, len(p.Levels))
+	for i, level := range p.Levels {
+		levels[i] = EscalationLevel{
+			AfterFailures:    level.AfterFailures,
+			AckTimeout:       level.AckTimeout,
+			SubscriptionName: level.SubscriptionName,
+		}
+		if level.Channels != nil {
+			levels[i].Channels = FromAddressModelsToDTOs(level.Channels)
+		}
+	}
+	return EscalationPolicy{Levels: levels}
+}
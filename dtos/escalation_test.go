@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func escalationPolicyData() EscalationPolicy {
+	return EscalationPolicy{
+		Levels: []EscalationLevel{
+			{AfterFailures: 3, AckTimeout: "10m", SubscriptionName: "on-call"},
+			{AckTimeout: "30m", Channels: []Address{testEmailAddress}},
+		},
+	}
+}
+
+func TestEscalationPolicy_Validate(t *testing.T) {
+	valid := escalationPolicyData()
+	noLevels := EscalationPolicy{}
+	noTrigger := escalationPolicyData()
+	noTrigger.Levels[0] = EscalationLevel{SubscriptionName: "on-call"}
+	noTarget := escalationPolicyData()
+	noTarget.Levels[1].Channels = nil
+	bothTargets := escalationPolicyData()
+	bothTargets.Levels[1].SubscriptionName = "managers"
+	negativeFailures := escalationPolicyData()
+	negativeFailures.Levels[0].AfterFailures = -1
+	invalidAckTimeout := escalationPolicyData()
+	invalidAckTimeout.Levels[0].AckTimeout = "10"
+	invalidChannel := escalationPolicyData()
+	invalidEmail := testEmailAddress
+	invalidEmail.Recipients = []string{"manager.example.com"}
+	invalidChannel.Levels[1].Channels = []Address{invalidEmail}
+
+	tests := []struct {
+		name        string
+		policy      EscalationPolicy
+		expectError bool
+	}{
+		{"valid", valid, false},
+		{"invalid, no levels", noLevels, true},
+		{"invalid, level without trigger", noTrigger, true},
+		{"invalid, level without target", noTarget, true},
+		{"invalid, level with both subscription and channels", bothTargets, true},
+		{"invalid, negative failures", negativeFailures, true},
+		{"invalid, ack timeout is not a duration", invalidAckTimeout, true},
+		{"invalid, invalid channel", invalidChannel, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected EscalationPolicy validation result.", err)
+		})
+	}
+}
+
+func TestEscalationPolicyModelConversion(t *testing.T) {
+	dto := escalationPolicyData()
+	model := ToEscalationPolicyModel(dto)
+	require.Len(t, model.Levels, 2)
+	assert.Equal(t, "on-call", model.Levels[0].SubscriptionName)
+	assert.Nil(t, model.Levels[0].Channels)
+	assert.Equal(t, ToAddressModels(dto.Levels[1].Channels), model.Levels[1].Channels)
+	assert.Equal(t, dto, FromEscalationPolicyModelToDTO(model))
+
+	subscription := Subscription{Name: "subscription", EscalationPolicy: &dto}
+	subscriptionModel := ToSubscriptionModel(subscription)
+	require.NotNil(t, subscriptionModel.EscalationPolicy)
+	assert.Equal(t, model, *subscriptionModel.EscalationPolicy)
+	assert.Equal(t, &dto, FromSubscriptionModelToDTO(subscriptionModel).EscalationPolicy)
+}
@@ -31,6 +31,37 @@ type Event struct {
 	Readings              []BaseReading  `json:"readings" validate:"gt=0,dive,required"`
 	Tags                  Tags           `json:"tags,omitempty"`
 	Extensions            map[string]any `json:"-" xml:"-"`
+	encodeOptions         *EventEncodeOptions
+}
+
+// EventEncodeOptions defines the options to encode the Event with JSON or CBOR
+type EventEncodeOptions struct {
+	// OptimizePayload omits the reading fields which can be recovered from the Event, i.e. Id, DeviceName,
+	// ProfileName, Origin and the ResourceName of a single reading. The encoded Event always carries a marker
+	// telling whether the payload is optimized, so that the decoder recovers these fields, or leaves them as
+	// they are, regardless of its environment.
+	OptimizePayload bool
+}
+
+// DefaultEventEncodeOptions returns the options determined by the EDGEX_OPTIMIZE_EVENT_PAYLOAD environment variable
+func DefaultEventEncodeOptions() EventEncodeOptions {
+	return EventEncodeOptions{
+		OptimizePayload: os.Getenv(common.EnvOptimizeEventPayload) == common.ValueTrue,
+	}
+}
+
+// WithEncodeOptions returns a copy of the Event which is encoded with the specified options instead of the default ones
+func (e Event) WithEncodeOptions(options EventEncodeOptions) Event {
+	e.encodeOptions = &options
+	return e
+}
+
+// getEncodeOptions returns the options specified by WithEncodeOptions, or the default options
+func (e Event) getEncodeOptions() EventEncodeOptions {
+	if e.encodeOptions != nil {
+		return *e.encodeOptions
+	}
+	return DefaultEventEncodeOptions()
 }
 
 // NewEvent creates and returns an initialized Event with no Readings
@@ -112,7 +143,7 @@ func (e Event) MarshalJSON() ([]byte, error) {
 	if err != nil || len(e.Extensions) == 0 {
 		return data, err
 	}
-	return mergeExtensions(data, e.Extensions, json.Unmarshal, json.Marshal)
+	return mergeExtensions(data, e.Extensions, jsonUnmarshalUseNumber, json.Marshal)
 }
 
 func (e Event) MarshalCBOR() ([]byte, error) {
@@ -133,6 +164,7 @@ func (e Event) marshal(marshal func(any) ([]byte, error)) ([]byte, error) {
 		Origin                int64         `json:"origin"`
 		Readings              []BaseReading `json:"readings"`
 		Tags                  Tags          `json:"tags,omitempty"`
+		OptimizedPayload      bool          `json:"optimizedPayload"`
 	}
 	aux.Versionable = e.Versionable
 	aux.Id = e.Id
@@ -145,7 +177,8 @@ func (e Event) marshal(marshal func(any) ([]byte, error)) ([]byte, error) {
 		aux.Readings = make([]BaseReading, len(e.Readings))
 	}
 
-	if os.Getenv(common.EnvOptimizeEventPayload) == common.ValueTrue {
+	if e.getEncodeOptions().OptimizePayload {
+		aux.OptimizedPayload = true
 		for i, reading := range e.Readings {
 			reading.Id = ""
 			reading.DeviceName = ""
@@ -233,6 +266,12 @@ func (e *Event) unmarshal(data []byte, unmarshal func([]byte, any) error) error
 		}
 	}
 
+	// decode the registered extensions before convertJSONNumbers to keep the numeric precision
+	typedExtensions, err := popRegisteredExtensions(rawMap, ExtensionScopeEvent)
+	if err != nil {
+		return err
+	}
+
 	// convert json.Number in rawMap to native numeric types before assigning Tags/Extensions
 	convertJSONNumbers(rawMap)
 
@@ -261,7 +300,17 @@ func (e *Event) unmarshal(data []byte, unmarshal func([]byte, any) error) error
 		e.Readings = make([]BaseReading, 0)
 	}
 
-	if os.Getenv(common.EnvOptimizeEventPayload) == common.ValueTrue {
+	// the marker is always encoded, so only the legacy payload without the marker relies on the environment
+	// variable to tell whether the fields should be recovered
+	_, hasMarker := rawMap[keyOptimizedPayload]
+	optimized, err := popBoolValueFromKey(rawMap, keyOptimizedPayload)
+	if err != nil {
+		return err
+	}
+	if !hasMarker {
+		optimized = os.Getenv(common.EnvOptimizeEventPayload) == common.ValueTrue
+	}
+	if optimized {
 		// recover the reduced fields
 		for i, reading := range e.Readings {
 			e.Readings[i].DeviceName = e.DeviceName
@@ -281,5 +330,8 @@ func (e *Event) unmarshal(data []byte, unmarshal func([]byte, any) error) error
 	} else {
 		e.Extensions = make(map[string]any)
 	}
+	for k, v := range typedExtensions {
+		e.Extensions[k] = v
+	}
 	return nil
 }
@@ -0,0 +1,346 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/protobuf"
+)
+
+// MarshalProtobuf encodes the Event with the protobuf wire format
+func (e Event) MarshalProtobuf() ([]byte, error) {
+	pbEvent, err := ToEventProtobuf(e)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pbEvent)
+}
+
+// UnmarshalProtobuf decodes the protobuf wire format into the Event
+func (e *Event) UnmarshalProtobuf(data []byte) error {
+	var pbEvent protobuf.Event
+	if err := proto.Unmarshal(data, &pbEvent); err != nil {
+		return err
+	}
+	event, err := FromEventProtobufToDTO(&pbEvent)
+	if err != nil {
+		return err
+	}
+	*e = event
+	return nil
+}
+
+// ToEventProtobuf transforms the Event DTO to the Event protobuf message
+func ToEventProtobuf(e Event) (*protobuf.Event, error) {
+	tags, err := toTagsProtobuf(e.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tags: %w", err)
+	}
+	extensions, err := toExtensionsProtobuf(e.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extensions: %w", err)
+	}
+	pbEvent := &protobuf.Event{
+		ApiVersion:  e.ApiVersion,
+		Id:          e.Id,
+		DeviceName:  e.DeviceName,
+		ProfileName: e.ProfileName,
+		SourceName:  e.SourceName,
+		Origin:      e.Origin,
+		Tags:        tags,
+		Extensions:  extensions,
+	}
+	if len(e.Readings) > 0 {
+		pbEvent.Readings = make([]*protobuf.BaseReading, len(e.Readings))
+		for i, r := range e.Readings {
+			if pbEvent.Readings[i], err = toReadingProtobuf(r); err != nil {
+				return nil, fmt.Errorf("failed to encode readings[%d]: %w", i, err)
+			}
+		}
+	}
+	return pbEvent, nil
+}
+
+// FromEventProtobufToDTO transforms the Event protobuf message to the Event DTO, and decodes the registered extensions
+func FromEventProtobufToDTO(pbEvent *protobuf.Event) (Event, error) {
+	e := Event{
+		Id:          pbEvent.GetId(),
+		DeviceName:  pbEvent.GetDeviceName(),
+		ProfileName: pbEvent.GetProfileName(),
+		SourceName:  pbEvent.GetSourceName(),
+		Origin:      pbEvent.GetOrigin(),
+		Readings:    make([]BaseReading, len(pbEvent.GetReadings())),
+		Tags:        fromTagsProtobuf(pbEvent.GetTags()),
+		Extensions:  fromExtensionsProtobuf(pbEvent.GetExtensions()),
+	}
+	e.ApiVersion = pbEvent.GetApiVersion()
+	if err := decodeRegisteredExtensions(e.Extensions, ExtensionScopeEvent); err != nil {
+		return Event{}, err
+	}
+	for i, r := range pbEvent.GetReadings() {
+		e.Readings[i] = fromReadingProtobuf(r)
+		if err := decodeRegisteredExtensions(e.Readings[i].Extensions, ExtensionScopeReading); err != nil {
+			return Event{}, err
+		}
+	}
+	return e, nil
+}
+
+func toReadingProtobuf(r BaseReading) (*protobuf.BaseReading, error) {
+	tags, err := toTagsProtobuf(r.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tags: %w", err)
+	}
+	extensions, err := toExtensionsProtobuf(r.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode extensions: %w", err)
+	}
+	pbReading := &protobuf.BaseReading{
+		Id:           r.Id,
+		Origin:       r.Origin,
+		DeviceName:   r.DeviceName,
+		ResourceName: r.ResourceName,
+		ProfileName:  r.ProfileName,
+		ValueType:    r.ValueType,
+		Units:        r.Units,
+		Tags:         tags,
+		Extensions:   extensions,
+	}
+
+	// choose the reading variant with the same rules as BaseReading.marshal
+	switch {
+	case r.isNull:
+		pbReading.Reading = &protobuf.BaseReading_NullReading{NullReading: &protobuf.NullReading{}}
+	case r.ValueType == common.ValueTypeObject || r.ValueType == common.ValueTypeObjectArray:
+		objectValue, err := toValueProtobuf(r.ObjectValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode objectValue: %w", err)
+		}
+		pbReading.Reading = &protobuf.BaseReading_ObjectReading{ObjectReading: &protobuf.ObjectReading{ObjectValue: objectValue}}
+	case r.ValueType == common.ValueTypeBinary:
+		pbReading.Reading = &protobuf.BaseReading_BinaryReading{BinaryReading: &protobuf.BinaryReading{
+			BinaryValue: r.BinaryValue,
+			MediaType:   r.MediaType,
+		}}
+	case isNumericValueType(r.ValueType) && r.NumericValue != nil:
+		numericValue, err := toValueProtobuf(r.NumericValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode numeric value: %w", err)
+		}
+		pbReading.Reading = &protobuf.BaseReading_NumericReading{NumericReading: &protobuf.NumericReading{NumericValue: numericValue}}
+	default:
+		pbReading.Reading = &protobuf.BaseReading_SimpleReading{SimpleReading: &protobuf.SimpleReading{Value: r.Value}}
+	}
+	return pbReading, nil
+}
+
+func fromReadingProtobuf(pbReading *protobuf.BaseReading) BaseReading {
+	r := BaseReading{
+		Id:           pbReading.GetId(),
+		Origin:       pbReading.GetOrigin(),
+		DeviceName:   pbReading.GetDeviceName(),
+		ResourceName: pbReading.GetResourceName(),
+		ProfileName:  pbReading.GetProfileName(),
+		ValueType:    pbReading.GetValueType(),
+		Units:        pbReading.GetUnits(),
+		Tags:         fromTagsProtobuf(pbReading.GetTags()),
+		Extensions:   fromExtensionsProtobuf(pbReading.GetExtensions()),
+	}
+
+	switch v := pbReading.GetReading().(type) {
+	case *protobuf.BaseReading_SimpleReading:
+		r.Value = v.SimpleReading.GetValue()
+	case *protobuf.BaseReading_BinaryReading:
+		r.BinaryValue = v.BinaryReading.GetBinaryValue()
+		r.MediaType = v.BinaryReading.GetMediaType()
+	case *protobuf.BaseReading_ObjectReading:
+		r.ObjectValue = fromValueProtobuf(v.ObjectReading.GetObjectValue())
+	case *protobuf.BaseReading_NumericReading:
+		// keep the SimpleReading in line with the JSON and CBOR decoding of a numeric value
+		r.NumericValue = fromValueProtobuf(v.NumericReading.GetNumericValue())
+		r.Value = fmt.Sprintf("%v", r.NumericValue)
+	default:
+		r.isNull = true
+	}
+	return r
+}
+
+func toTagsProtobuf(tags Tags) (*protobuf.Tags, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	values, err := toMapValueProtobuf(tags)
+	if err != nil {
+		return nil, err
+	}
+	return &protobuf.Tags{Values: values.GetValues()}, nil
+}
+
+func fromTagsProtobuf(pbTags *protobuf.Tags) Tags {
+	tags := make(Tags, len(pbTags.GetValues()))
+	for k, v := range pbTags.GetValues() {
+		tags[k] = fromValueProtobuf(v)
+	}
+	return tags
+}
+
+func toExtensionsProtobuf(extensions map[string]any) (*protobuf.MapValue, error) {
+	if len(extensions) == 0 {
+		return nil, nil
+	}
+	return toMapValueProtobuf(extensions)
+}
+
+func fromExtensionsProtobuf(pbExtensions *protobuf.MapValue) map[string]any {
+	extensions := make(map[string]any, len(pbExtensions.GetValues()))
+	for k, v := range pbExtensions.GetValues() {
+		extensions[k] = fromValueProtobuf(v)
+	}
+	return extensions
+}
+
+func toMapValueProtobuf(m map[string]any) (*protobuf.MapValue, error) {
+	values := make(map[string]*protobuf.Value, len(m))
+	for k, v := range m {
+		value, err := toValueProtobuf(v)
+		if err != nil {
+			return nil, err
+		}
+		values[k] = value
+	}
+	return &protobuf.MapValue{Values: values}, nil
+}
+
+// toValueProtobuf converts the value to the protobuf Value. The signed and unsigned integers are kept as integers to
+// preserve the precision, and the values of other types, such as structs, are converted through their JSON
+// representation.
+func toValueProtobuf(v any) (*protobuf.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return &protobuf.Value{}, nil
+	case json.Number:
+		return jsonNumberToValueProtobuf(val)
+	case []byte:
+		return &protobuf.Value{Kind: &protobuf.Value_BytesValue{BytesValue: val}}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return &protobuf.Value{Kind: &protobuf.Value_BoolValue{BoolValue: rv.Bool()}}, nil
+	case reflect.String:
+		return &protobuf.Value{Kind: &protobuf.Value_StringValue{StringValue: rv.String()}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &protobuf.Value{Kind: &protobuf.Value_IntValue{IntValue: rv.Int()}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &protobuf.Value{Kind: &protobuf.Value_UintValue{UintValue: rv.Uint()}}, nil
+	case reflect.Float32, reflect.Float64:
+		return &protobuf.Value{Kind: &protobuf.Value_DoubleValue{DoubleValue: rv.Float()}}, nil
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return &protobuf.Value{}, nil
+		}
+		return toValueProtobuf(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return &protobuf.Value{}, nil
+		}
+		values := make([]*protobuf.Value, rv.Len())
+		for i := range values {
+			value, err := toValueProtobuf(rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return &protobuf.Value{Kind: &protobuf.Value_ListValue{ListValue: &protobuf.ListValue{Values: values}}}, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if rv.IsNil() {
+			return &protobuf.Value{}, nil
+		}
+		values := make(map[string]*protobuf.Value, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			value, err := toValueProtobuf(iter.Value().Interface())
+			if err != nil {
+				return nil, err
+			}
+			values[iter.Key().String()] = value
+		}
+		return &protobuf.Value{Kind: &protobuf.Value_MapValue{MapValue: &protobuf.MapValue{Values: values}}}, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic any
+	if err = dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return toValueProtobuf(generic)
+}
+
+// jsonNumberToValueProtobuf keeps the JSON number as an integer if it fits in int64 or uint64
+func jsonNumberToValueProtobuf(n json.Number) (*protobuf.Value, error) {
+	if i, err := n.Int64(); err == nil {
+		return &protobuf.Value{Kind: &protobuf.Value_IntValue{IntValue: i}}, nil
+	}
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		return &protobuf.Value{Kind: &protobuf.Value_UintValue{UintValue: u}}, nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return nil, err
+	}
+	return &protobuf.Value{Kind: &protobuf.Value_DoubleValue{DoubleValue: f}}, nil
+}
+
+// fromValueProtobuf converts the protobuf Value to the Go value, where the integers are decoded as int64 or uint64,
+// the lists as []any and the maps as map[string]any
+func fromValueProtobuf(value *protobuf.Value) any {
+	switch v := value.GetKind().(type) {
+	case *protobuf.Value_DoubleValue:
+		return v.DoubleValue
+	case *protobuf.Value_IntValue:
+		return v.IntValue
+	case *protobuf.Value_UintValue:
+		return v.UintValue
+	case *protobuf.Value_StringValue:
+		return v.StringValue
+	case *protobuf.Value_BoolValue:
+		return v.BoolValue
+	case *protobuf.Value_BytesValue:
+		return v.BytesValue
+	case *protobuf.Value_ListValue:
+		values := make([]any, len(v.ListValue.GetValues()))
+		for i, item := range v.ListValue.GetValues() {
+			values[i] = fromValueProtobuf(item)
+		}
+		return values
+	case *protobuf.Value_MapValue:
+		values := make(map[string]any, len(v.MapValue.GetValues()))
+		for k, item := range v.MapValue.GetValues() {
+			values[k] = fromValueProtobuf(item)
+		}
+		return values
+	default:
+		return nil
+	}
+}
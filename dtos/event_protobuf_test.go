@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+func protobufTestEvent(readings ...BaseReading) Event {
+	event := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	event.Id = TestUUID
+	event.Origin = TestTimestamp
+	event.Readings = append(event.Readings, readings...)
+	return event
+}
+
+func protobufTestReading(reading BaseReading) BaseReading {
+	reading.Id = TestUUID
+	reading.Origin = TestTimestamp
+	return reading
+}
+
+func TestEvent_ProtobufRoundTrip(t *testing.T) {
+	simpleReading := testSimpleReading
+	simpleReading.Extensions = make(map[string]any)
+	tagsEvent := protobufTestEvent(simpleReading)
+	tagsEvent.Tags = expectedDTO.Tags
+
+	numericReading := protobufTestReading(NewNumericReading(TestDeviceProfileName, TestDeviceName, TestReadingName, common.ValueTypeFloat64, 1.5))
+	// the SimpleReading is always derived from the decoded numeric value, as the JSON and CBOR decoding do
+	numericReading.Value = "1.5"
+
+	extensionsEvent := protobufTestEvent(simpleReading)
+	extensionsEvent.Extensions = map[string]any{"description": TestDescription}
+	extensionsEvent.Readings[0].Extensions = map[string]any{"quality": map[string]any{"code": 192.0}}
+
+	tests := []struct {
+		name  string
+		event Event
+	}{
+		{"simple reading", protobufTestEvent(simpleReading)},
+		{"tags", tagsEvent},
+		{"binary reading", protobufTestEvent(protobufTestReading(
+			NewBinaryReading(TestDeviceProfileName, TestDeviceName, TestReadingName, []byte("Hello World"), common.ContentTypeText)))},
+		{"object reading", protobufTestEvent(protobufTestReading(
+			NewObjectReading(TestDeviceProfileName, TestDeviceName, TestReadingName, map[string]any{"Attr1": "yyz", "Attr2": -45.0, "Attr3": []any{255.0, 1.0, 0.0}})))},
+		{"null readings", protobufTestEvent(
+			protobufTestReading(NewNullReading(TestDeviceProfileName, TestDeviceName, TestReadingName, common.ValueTypeInt8)),
+			protobufTestReading(NewNullReading(TestDeviceProfileName, TestDeviceName, TestReadingName, common.ValueTypeBinary)),
+			protobufTestReading(NewNullReading(TestDeviceProfileName, TestDeviceName, TestReadingName, common.ValueTypeObject)))},
+		{"numeric reading", protobufTestEvent(numericReading)},
+		{"extensions", extensionsEvent},
+		{"no readings", protobufTestEvent()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.event.MarshalProtobuf()
+			require.NoError(t, err)
+
+			var result Event
+			require.NoError(t, result.UnmarshalProtobuf(data))
+			assert.Equal(t, tt.event, result)
+
+			// the decoded Event should produce the same JSON payload as the original one
+			expectedJSON, err := json.Marshal(tt.event)
+			require.NoError(t, err)
+			resultJSON, err := json.Marshal(result)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expectedJSON), string(resultJSON))
+		})
+	}
+}
+
+func TestEvent_ProtobufRoundTripLargeIntegers(t *testing.T) {
+	const largeInt64 = int64(math.MaxInt64 - 1)
+	const largeUint64 = uint64(math.MaxUint64 - 1)
+	event := protobufTestEvent()
+	event.Tags = Tags{"sequence": largeInt64}
+	event.AddObjectReading(TestReadingName, map[string]any{"counter": largeInt64, "total": largeUint64, "values": []any{int64(1<<53 + 1)}})
+	require.NoError(t, event.AddSimpleReading(TestDeviceResourceName, common.ValueTypeInt64, largeInt64))
+	event.Readings = append(event.Readings, NewNumericReading(TestDeviceProfileName, TestDeviceName, TestReadingName, common.ValueTypeUint64, largeUint64))
+
+	data, err := event.MarshalProtobuf()
+	require.NoError(t, err)
+
+	var result Event
+	require.NoError(t, result.UnmarshalProtobuf(data))
+	assert.Equal(t, largeInt64, result.Tags["sequence"])
+	assert.Equal(t, map[string]any{"counter": largeInt64, "total": largeUint64, "values": []any{int64(1<<53 + 1)}}, result.Readings[0].ObjectValue)
+	assert.Equal(t, event.Readings[1].Value, result.Readings[1].Value)
+	assert.Equal(t, largeUint64, result.Readings[2].NumericValue)
+	assert.Equal(t, "18446744073709551614", result.Readings[2].Value)
+}
+
+func TestEvent_MarshalProtobufWithTypedValues(t *testing.T) {
+	event := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	event.Tags = Tags{"labels": []string{TestTag1, TestTag2}}
+	event.AddObjectReading(TestReadingName, map[string]any{"Attr1": "yyz", "Attr2": []int{1, 2}, "Attr3": struct {
+		Value float32 `json:"value"`
+	}{Value: 0.5}})
+	event.AddNullReading(TestDeviceResourceName, common.ValueTypeInt8)
+
+	data, err := event.MarshalProtobuf()
+	require.NoError(t, err)
+
+	var result Event
+	require.NoError(t, result.UnmarshalProtobuf(data))
+	assert.Equal(t, []any{TestTag1, TestTag2}, result.Tags["labels"])
+	assert.Equal(t, map[string]any{"Attr1": "yyz", "Attr2": []any{int64(1), int64(2)}, "Attr3": map[string]any{"value": 0.5}},
+		result.Readings[0].ObjectValue)
+	assert.True(t, result.Readings[1].IsNull())
+}
+
+func TestEvent_UnmarshalProtobufInvalidData(t *testing.T) {
+	var result Event
+	assert.Error(t, result.UnmarshalProtobuf([]byte("Invalid Event")))
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
-	"os"
 	"testing"
 
 	"github.com/fxamacker/cbor/v2"
@@ -300,8 +299,7 @@ func TestEvent_MarshalOptimizedEventPayload(t *testing.T) {
 	testEvent.Readings[0].Id = TestUUID
 	testEvent.Readings[0].Origin = TestTimestamp
 
-	err = os.Setenv(common.EnvOptimizeEventPayload, common.ValueTrue)
-	require.NoError(t, err)
+	t.Setenv(common.EnvOptimizeEventPayload, common.ValueTrue)
 
 	// Marshal reduce the reading fields
 	data, err := json.Marshal(testEvent)
@@ -310,7 +308,7 @@ func TestEvent_MarshalOptimizedEventPayload(t *testing.T) {
 		fmt.Sprintf(
 			`{"apiVersion":"v3", "id":"%s",
 					"deviceName":"TestDevice","profileName":"TestDeviceProfileName","sourceName":"TestSourceName","origin":%d,
-					"readings":[{"resourceName":"TestDeviceResource","valueType":"Uint8","value":"255"}]}`, TestUUID, TestTimestamp),
+					"readings":[{"resourceName":"TestDeviceResource","valueType":"Uint8","value":"255"}],"optimizedPayload":true}`, TestUUID, TestTimestamp),
 		string(data))
 
 	// Unmarshal recover the reading fields
@@ -322,6 +320,103 @@ func TestEvent_MarshalOptimizedEventPayload(t *testing.T) {
 	assert.Equal(t, testEvent, res)
 }
 
+func TestEvent_EncodeOptions(t *testing.T) {
+	testEvent := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	testEvent.Id = TestUUID
+	testEvent.Origin = TestTimestamp
+	require.NoError(t, testEvent.AddSimpleReading(TestReadingName, common.ValueTypeUint8, uint8(math.MaxUint8)))
+	require.NoError(t, testEvent.AddSimpleReading(TestDeviceResourceName, common.ValueTypeString, TestValue))
+	testEvent.Readings[1].Origin = TestTimestamp + 1
+
+	expected := testEvent
+	expected.Readings = []BaseReading{testEvent.Readings[0], testEvent.Readings[1]}
+	for i := range expected.Readings {
+		expected.Readings[i].Id = ""
+	}
+
+	tests := []struct {
+		name      string
+		marshal   func(any) ([]byte, error)
+		unmarshal func([]byte, any) error
+	}{
+		{"JSON", json.Marshal, json.Unmarshal},
+		{"CBOR", cbor.Marshal, cbor.Unmarshal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.marshal(testEvent.WithEncodeOptions(EventEncodeOptions{OptimizePayload: true}))
+			require.NoError(t, err)
+
+			var rawMap map[string]any
+			require.NoError(t, tt.unmarshal(data, &rawMap))
+			assert.Equal(t, true, rawMap[keyOptimizedPayload])
+
+			// the decoder recovers the reading fields from the marker without the environment variable
+			var res Event
+			require.NoError(t, tt.unmarshal(data, &res))
+			assert.Equal(t, expected, res)
+			assert.Empty(t, res.Extensions)
+			assert.Equal(t, int64(TestTimestamp+1), res.Readings[1].Origin)
+		})
+	}
+}
+
+func TestEvent_EncodeOptionsOverrideEnvironment(t *testing.T) {
+	t.Setenv(common.EnvOptimizeEventPayload, common.ValueTrue)
+	testEvent := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	require.NoError(t, testEvent.AddSimpleReading(TestReadingName, common.ValueTypeUint8, uint8(math.MaxUint8)))
+
+	data, err := json.Marshal(testEvent.WithEncodeOptions(EventEncodeOptions{OptimizePayload: false}))
+	require.NoError(t, err)
+
+	var rawMap map[string]any
+	require.NoError(t, json.Unmarshal(data, &rawMap))
+	assert.Equal(t, false, rawMap[keyOptimizedPayload])
+	reading := rawMap[keyReadings].([]any)[0].(map[string]any)
+	assert.Equal(t, testEvent.Readings[0].Id, reading[keyId])
+	assert.Equal(t, TestDeviceName, reading[keyDeviceName])
+}
+
+func TestEvent_UnmarshalNotOptimizedPayloadWithEnvironment(t *testing.T) {
+	testEvent := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	require.NoError(t, testEvent.AddSimpleReading(TestReadingName, common.ValueTypeUint8, uint8(math.MaxUint8)))
+	// the reading fields differ from the ones recovered from the Event
+	testEvent.Readings[0].DeviceName = "OtherDevice"
+	testEvent.Readings[0].Origin = 0
+	data, err := json.Marshal(testEvent.WithEncodeOptions(EventEncodeOptions{OptimizePayload: false}))
+	require.NoError(t, err)
+
+	// the receiver with the environment variable set doesn't rewrite the reading fields of the marked payload
+	t.Setenv(common.EnvOptimizeEventPayload, common.ValueTrue)
+	var res Event
+	require.NoError(t, json.Unmarshal(data, &res))
+	assert.Equal(t, "OtherDevice", res.Readings[0].DeviceName)
+	assert.Zero(t, res.Readings[0].Origin)
+	assert.Equal(t, TestReadingName, res.Readings[0].ResourceName)
+}
+
+func TestEvent_UnmarshalOptimizedPayloadWithoutMarker(t *testing.T) {
+	data := fmt.Sprintf(`{"apiVersion":"v3","id":"%s","deviceName":"TestDevice","profileName":"TestDeviceProfileName",`+
+		`"sourceName":"TestSourceName","origin":%d,"readings":[{"valueType":"Uint8","value":"255"}]}`, TestUUID, TestTimestamp)
+
+	var res Event
+	require.NoError(t, json.Unmarshal([]byte(data), &res))
+	assert.Empty(t, res.Readings[0].DeviceName, "fields shouldn't be recovered without the marker or environment variable")
+
+	t.Setenv(common.EnvOptimizeEventPayload, common.ValueTrue)
+	require.NoError(t, json.Unmarshal([]byte(data), &res))
+	assert.Equal(t, TestDeviceName, res.Readings[0].DeviceName)
+	assert.Equal(t, TestDeviceProfileName, res.Readings[0].ProfileName)
+	assert.Equal(t, TestSourceName, res.Readings[0].ResourceName)
+	assert.Equal(t, int64(TestTimestamp), res.Readings[0].Origin)
+}
+
+func TestEvent_UnmarshalInvalidOptimizedPayloadMarker(t *testing.T) {
+	data := `{"apiVersion":"v3","deviceName":"TestDevice","origin":1594963842,"readings":[],"optimizedPayload":"true"}`
+	var res Event
+	assert.Error(t, json.Unmarshal([]byte(data), &res))
+}
+
 func TestEvent_UnmarshalExtensions(t *testing.T) {
 	baseJSON := `{"apiVersion":"v3","deviceName":"TestDevice","profileName":"TestDeviceProfileName",` +
 		`"sourceName":"TestSourceName","origin":1594963842,"readings":[], "description":"d"}`
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ExtensionScope identifies the DTO which carries an extension
+type ExtensionScope string
+
+const (
+	ExtensionScopeEvent   ExtensionScope = "Event"
+	ExtensionScopeReading ExtensionScope = "Reading"
+)
+
+// extensionSchema decodes and validates the raw value of a registered extension
+type extensionSchema struct {
+	decode func(raw any) (any, error)
+}
+
+var (
+	extensionMutex   sync.RWMutex
+	extensionSchemas = map[ExtensionScope]map[string]extensionSchema{
+		ExtensionScopeEvent:   {},
+		ExtensionScopeReading: {},
+	}
+	reservedExtensionKeys = map[ExtensionScope][]string{
+		ExtensionScopeEvent: {keyApiVersion, keyId, keyDeviceName, keyProfileName, keySourceName, keyOrigin, keyReadings,
+			keyTags, keyOptimizedPayload},
+		ExtensionScopeReading: {keyId, keyDeviceName, keyProfileName, keyResourceName, keyOrigin, keyValueType, keyUnits,
+			keyTags, keyBinaryValue, keyMediaType, keyObjectValue, keyValue},
+	}
+)
+
+// RegisterExtension registers the Go type and the optional validator of the extension key in the scope. The value of
+// a registered extension is decoded to the type T when unmarshalling the Event or reading, and the unmarshalling fails
+// if the value can't be decoded or the validator returns an error. Registering the same key again replaces the schema.
+func RegisterExtension[T any](scope ExtensionScope, key string, validator func(T) error) errors.EdgeX {
+	reservedKeys, ok := reservedExtensionKeys[scope]
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported extension scope '%s'", scope), nil)
+	}
+	if key == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "extension key must not be empty", nil)
+	}
+	for _, reservedKey := range reservedKeys {
+		if key == reservedKey {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("extension key '%s' is reserved by the %s", key, scope), nil)
+		}
+	}
+
+	schema := extensionSchema{
+		decode: func(raw any) (any, error) {
+			value, err := decodeExtensionValue[T](raw)
+			if err != nil {
+				return nil, err
+			}
+			if validator != nil {
+				if err = validator(value); err != nil {
+					return nil, err
+				}
+			}
+			return value, nil
+		},
+	}
+
+	extensionMutex.Lock()
+	defer extensionMutex.Unlock()
+	extensionSchemas[scope][key] = schema
+	return nil
+}
+
+// UnregisterExtension removes the extension key registered in the scope
+func UnregisterExtension(scope ExtensionScope, key string) {
+	extensionMutex.Lock()
+	defer extensionMutex.Unlock()
+	delete(extensionSchemas[scope], key)
+}
+
+// GetExtension returns the extension value with the type T, and whether the key exists with such type
+func GetExtension[T any](extensions map[string]any, key string) (T, bool) {
+	value, ok := extensions[key].(T)
+	return value, ok
+}
+
+// decodeExtensionValue converts the raw value decoded from JSON or CBOR to the type T through its JSON representation
+func decodeExtensionValue[T any](raw any) (T, error) {
+	var value T
+	if v, ok := raw.(T); ok {
+		return v, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return value, err
+	}
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+// popRegisteredExtensions removes the registered extension keys of the scope from the raw map and returns their
+// decoded values. It should be invoked before convertJSONNumbers so that the numbers keep their precision.
+func popRegisteredExtensions(rawMap map[string]any, scope ExtensionScope) (map[string]any, error) {
+	extensionMutex.RLock()
+	defer extensionMutex.RUnlock()
+
+	var extensions map[string]any
+	for key, schema := range extensionSchemas[scope] {
+		raw, ok := rawMap[key]
+		if !ok {
+			continue
+		}
+		delete(rawMap, key)
+		value, err := schema.decode(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s extension %q: %w", scope, key, err)
+		}
+		if extensions == nil {
+			extensions = make(map[string]any)
+		}
+		extensions[key] = value
+	}
+	return extensions, nil
+}
+
+// decodeRegisteredExtensions replaces the raw values of the registered extension keys with their decoded values
+func decodeRegisteredExtensions(extensions map[string]any, scope ExtensionScope) error {
+	decoded, err := popRegisteredExtensions(extensions, scope)
+	if err != nil {
+		return err
+	}
+	for k, v := range decoded {
+		extensions[k] = v
+	}
+	return nil
+}
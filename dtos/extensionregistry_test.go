@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+const (
+	testLocationExtension = "location"
+	testQualityExtension  = "quality"
+)
+
+type testGPSLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type testQualityCode struct {
+	Code      int64 `json:"code"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+func registerTestExtensions(t *testing.T) {
+	err := RegisterExtension(ExtensionScopeEvent, testLocationExtension, func(l testGPSLocation) error {
+		if l.Latitude < -90 || l.Latitude > 90 {
+			return errors.New("latitude out of range")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	err = RegisterExtension[testQualityCode](ExtensionScopeReading, testQualityExtension, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		UnregisterExtension(ExtensionScopeEvent, testLocationExtension)
+		UnregisterExtension(ExtensionScopeReading, testQualityExtension)
+	})
+}
+
+func TestRegisterExtension(t *testing.T) {
+	tests := []struct {
+		name        string
+		scope       ExtensionScope
+		key         string
+		expectedErr bool
+	}{
+		{"valid event extension", ExtensionScopeEvent, "gps", false},
+		{"valid reading extension", ExtensionScopeReading, "gps", false},
+		{"invalid scope", "Device", "gps", true},
+		{"empty key", ExtensionScopeEvent, "", true},
+		{"reserved event key", ExtensionScopeEvent, keySourceName, true},
+		{"reserved event marker key", ExtensionScopeEvent, keyOptimizedPayload, true},
+		{"reserved reading key", ExtensionScopeReading, keyValue, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterExtension[testGPSLocation](tt.scope, tt.key, nil)
+			defer UnregisterExtension(tt.scope, tt.key)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEvent_RegisteredExtensions(t *testing.T) {
+	registerTestExtensions(t)
+
+	event := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	event.Extensions[testLocationExtension] = testGPSLocation{Latitude: 25.5, Longitude: -80.25}
+	event.Extensions["description"] = "unregistered"
+	require.NoError(t, event.AddSimpleReading(TestReadingName, common.ValueTypeString, TestValue))
+	// the quality timestamp exceeds the float64 precision
+	event.Readings[0].Extensions[testQualityExtension] = testQualityCode{Code: 192, Timestamp: 1594963842000000001}
+
+	tests := []struct {
+		name      string
+		marshal   func(any) ([]byte, error)
+		unmarshal func([]byte, any) error
+	}{
+		{"JSON", json.Marshal, json.Unmarshal},
+		{"CBOR", cbor.Marshal, cbor.Unmarshal},
+		{"protobuf", func(v any) ([]byte, error) { return v.(Event).MarshalProtobuf() },
+			func(data []byte, v any) error { return v.(*Event).UnmarshalProtobuf(data) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.marshal(event)
+			require.NoError(t, err)
+
+			var result Event
+			require.NoError(t, tt.unmarshal(data, &result))
+
+			location, ok := GetExtension[testGPSLocation](result.Extensions, testLocationExtension)
+			require.True(t, ok)
+			assert.Equal(t, testGPSLocation{Latitude: 25.5, Longitude: -80.25}, location)
+			assert.Equal(t, "unregistered", result.Extensions["description"])
+
+			if tt.name != "protobuf" {
+				// the protobuf Struct stores numbers as float64
+				quality, ok := GetExtension[testQualityCode](result.Readings[0].Extensions, testQualityExtension)
+				require.True(t, ok)
+				assert.Equal(t, testQualityCode{Code: 192, Timestamp: 1594963842000000001}, quality)
+			}
+		})
+	}
+}
+
+func TestEvent_RegisteredExtensionsInvalid(t *testing.T) {
+	registerTestExtensions(t)
+
+	tests := []struct {
+		name      string
+		eventJSON string
+	}{
+		{"validation failed",
+			`{"apiVersion":"v3","deviceName":"TestDevice","origin":1594963842,"readings":[],"location":{"latitude":91,"longitude":0}}`},
+		{"wrong event extension type",
+			`{"apiVersion":"v3","deviceName":"TestDevice","origin":1594963842,"readings":[],"location":"here"}`},
+		{"wrong reading extension type",
+			`{"apiVersion":"v3","deviceName":"TestDevice","origin":1594963842,` +
+				`"readings":[{"deviceName":"TestDevice","resourceName":"r","valueType":"String","value":"v","quality":"good"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Event
+			assert.Error(t, json.Unmarshal([]byte(tt.eventJSON), &result))
+		})
+	}
+}
+
+func TestGetExtension(t *testing.T) {
+	extensions := map[string]any{testLocationExtension: testGPSLocation{Latitude: 1}, "raw": map[string]any{"latitude": 1.0}}
+
+	location, ok := GetExtension[testGPSLocation](extensions, testLocationExtension)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, location.Latitude)
+
+	_, ok = GetExtension[testGPSLocation](extensions, "raw")
+	assert.False(t, ok, "unregistered extension keeps the raw value")
+	_, ok = GetExtension[testGPSLocation](extensions, "absent")
+	assert.False(t, ok)
+	_, ok = GetExtension[testGPSLocation](nil, testLocationExtension)
+	assert.False(t, ok)
+}
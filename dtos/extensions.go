@@ -118,3 +118,16 @@ func popStringValueFromKey(m map[string]any, key string) (string, error) {
 		return "", fmt.Errorf("field %q must be a string, got %T", key, v)
 	}
 }
+
+// popBoolValueFromKey removes the key from the map and returns its bool value, or false if the key is absent
+func popBoolValueFromKey(m map[string]any, key string) (bool, error) {
+	v := popKey(m, key)
+	switch val := v.(type) {
+	case bool:
+		return val, nil
+	case nil:
+		return false, nil
+	default:
+		return false, fmt.Errorf("field %q must be a bool, got %T", key, v)
+	}
+}
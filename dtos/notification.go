@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -23,6 +23,9 @@ type Notification struct {
 	Severity     string   `json:"severity" validate:"required,oneof='MINOR' 'NORMAL' 'CRITICAL'"`
 	Status       string   `json:"status,omitempty" validate:"omitempty,oneof='NEW' 'PROCESSED' 'ESCALATED'"`
 	Acknowledged bool     `json:"acknowledged"`
+	// DedupKey identifies the repeated notifications, which are suppressed within the SuppressionWindow
+	DedupKey          string `json:"dedupKey,omitempty" validate:"required_with=SuppressionWindow,omitempty,edgex-dto-none-empty-string"`
+	SuppressionWindow string `json:"suppressionWindow,omitempty" validate:"omitempty,edgex-dto-duration"`
 }
 
 // NewNotification creates and returns a Notification DTO
@@ -51,6 +54,8 @@ func ToNotificationModel(n Notification) models.Notification {
 	m.Severity = models.NotificationSeverity(n.Severity)
 	m.Status = models.NotificationStatus(n.Status)
 	m.Acknowledged = n.Acknowledged
+	m.DedupKey = n.DedupKey
+	m.SuppressionWindow = n.SuppressionWindow
 	return m
 }
 
@@ -66,17 +71,19 @@ func ToNotificationModels(notifications []Notification) []models.Notification {
 // FromNotificationModelToDTO transforms the Notification Model to the Notification DTO
 func FromNotificationModelToDTO(n models.Notification) Notification {
 	return Notification{
-		DBTimestamp:  DBTimestamp(n.DBTimestamp),
-		Id:           n.Id,
-		Category:     string(n.Category),
-		Labels:       n.Labels,
-		Content:      n.Content,
-		ContentType:  n.ContentType,
-		Description:  n.Description,
-		Sender:       n.Sender,
-		Severity:     string(n.Severity),
-		Status:       string(n.Status),
-		Acknowledged: n.Acknowledged,
+		DBTimestamp:       DBTimestamp(n.DBTimestamp),
+		Id:                n.Id,
+		Category:          string(n.Category),
+		Labels:            n.Labels,
+		Content:           n.Content,
+		ContentType:       n.ContentType,
+		Description:       n.Description,
+		Sender:            n.Sender,
+		Severity:          string(n.Severity),
+		Status:            string(n.Status),
+		Acknowledged:      n.Acknowledged,
+		DedupKey:          n.DedupKey,
+		SuppressionWindow: n.SuppressionWindow,
 	}
 }
 
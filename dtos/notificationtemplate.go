@@ -0,0 +1,311 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// notificationTemplateFuncs are the functions available in the notification templates, e.g. {{ json .Content }}
+// renders the content as a quoted and escaped JSON string, {{ join .Labels ", " }} renders the labels as a list, and
+// {{ ackToken }} renders the ack token of the notification for the recipient, which fails the rendering unless the
+// template is rendered with an ack token, e.g. by RenderWithAckToken
+var notificationTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join":     strings.Join,
+	"ackToken": ackTokenFunc(""),
+}
+
+// ackTokenFunc returns the ackToken function of the templates, which fails if the ack token is empty so that a dead
+// acknowledge link is never sent
+func ackTokenFunc(ackToken string) func() (string, error) {
+	return func() (string, error) {
+		if ackToken == "" {
+			return "", goErrors.New("the template refers to the ack token, but no ack token is specified")
+		}
+		return ackToken, nil
+	}
+}
+
+// checkNotificationTemplateFields checks that the fields referred by the template exist in the Notification DTO, so
+// that the unknown fields are reported without executing the template. Only the fields of the dot outside range and
+// with, and the fields of $, are known to refer to the notification.
+func checkNotificationTemplateFields(tmpl *template.Template) errors.EdgeX {
+	if tmpl.Tree == nil {
+		return nil
+	}
+	return checkNotificationTemplateNode(tmpl.Tree.Root, true)
+}
+
+func checkNotificationTemplateNode(node parse.Node, isNotification bool) errors.EdgeX {
+	var nodes []parse.Node
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n != nil {
+			nodes = n.Nodes
+		}
+	case *parse.ActionNode:
+		nodes = []parse.Node{n.Pipe}
+	case *parse.TemplateNode:
+		nodes = []parse.Node{n.Pipe}
+	case *parse.IfNode:
+		nodes = []parse.Node{n.Pipe, n.List, n.ElseList}
+	case *parse.RangeNode:
+		// the dot of the range body is the element
+		if err := checkNotificationTemplateNode(n.List, false); err != nil {
+			return err
+		}
+		nodes = []parse.Node{n.Pipe, n.ElseList}
+	case *parse.WithNode:
+		// the dot of the with body is the value of the pipeline
+		if err := checkNotificationTemplateNode(n.List, false); err != nil {
+			return err
+		}
+		nodes = []parse.Node{n.Pipe, n.ElseList}
+	case *parse.PipeNode:
+		if n != nil {
+			for _, cmd := range n.Cmds {
+				nodes = append(nodes, cmd)
+			}
+		}
+	case *parse.CommandNode:
+		nodes = n.Args
+	case *parse.ChainNode:
+		nodes = []parse.Node{n.Node}
+	case *parse.FieldNode:
+		if isNotification {
+			return checkNotificationField(n.Ident[0])
+		}
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			return checkNotificationField(n.Ident[1])
+		}
+	}
+	for _, child := range nodes {
+		if err := checkNotificationTemplateNode(child, isNotification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkNotificationField(name string) errors.EdgeX {
+	notificationType := reflect.TypeFor[Notification]()
+	if _, ok := notificationType.FieldByName(name); ok {
+		return nil
+	}
+	if _, ok := reflect.PointerTo(notificationType).MethodByName(name); ok {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the notification has no field '%s'", name), nil)
+}
+
+// NotificationTemplate renders the notification content per channel with the text/template syntax over the
+// Notification DTO, e.g. "[{{ .Severity }}] {{ .Content }}". The Content variant applies to every channel without its
+// own variant, and the notification content is delivered as is if no variant applies. The template is managed by
+// name, and referred to by the TemplateName of the Subscription.
+type NotificationTemplate struct {
+	DBTimestamp  `json:",inline"`
+	Id           string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name         string `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description  string `json:"description,omitempty"`
+	Content      string `json:"content,omitempty"`
+	EmailSubject string `json:"emailSubject,omitempty"`
+	EmailBody    string `json:"emailBody,omitempty"`
+	MQTTPayload  string `json:"mqttPayload,omitempty"`
+}
+
+// UpdateNotificationTemplate is the patch of a NotificationTemplate, and an empty variant removes that of the
+// template. The variants are validated by UpdateNotificationTemplateRequest.Validate.
+type UpdateNotificationTemplate struct {
+	Id           *string `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name         *string `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Description  *string `json:"description"`
+	Content      *string `json:"content"`
+	EmailSubject *string `json:"emailSubject"`
+	EmailBody    *string `json:"emailBody"`
+	MQTTPayload  *string `json:"mqttPayload"`
+}
+
+// IsEmpty returns true if no field of the template is specified, which removes the inline template in
+// UpdateSubscription
+func (t NotificationTemplate) IsEmpty() bool {
+	return t == NotificationTemplate{}
+}
+
+// RenderedNotification is the notification content rendered for a channel
+type RenderedNotification struct {
+	// Subject is the subject of the EMAIL channel, which is empty if the template has no EmailSubject
+	Subject     string
+	Content     string
+	ContentType string
+}
+
+// Validate checks that every variant of the template is parsed and only refers to the Notification fields. The
+// template is not executed, so the errors depending on the notification, e.g. an index out of range, are only
+// reported when rendering.
+func (t *NotificationTemplate) Validate() error {
+	variants := []struct {
+		name string
+		text string
+	}{
+		{"content", t.Content},
+		{"emailSubject", t.EmailSubject},
+		{"emailBody", t.EmailBody},
+		{"mqttPayload", t.MQTTPayload},
+	}
+	for _, v := range variants {
+		if v.text == "" {
+			continue
+		}
+		if _, err := parseNotificationTemplate(v.name, v.text, ""); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid %s variant of the notification template '%s'", v.name, t.Name), err)
+		}
+	}
+	return nil
+}
+
+// Render renders the notification for the channel type. The MQTT payload must be a valid JSON document, and the
+// template referring to {{ ackToken }} must be rendered by RenderWithAckToken instead.
+func (t NotificationTemplate) Render(channelType string, notification Notification) (RenderedNotification, errors.EdgeX) {
+	return t.RenderWithAckToken(channelType, notification, "")
+}
+
+// RenderWithAckToken renders the notification for the channel type like Render, and the {{ ackToken }} in the
+// template renders the ack token, which is usually generated by GenerateAckToken for the recipient of the channel.
+// The acknowledge API only accepts PUT, while a link in an email is opened by GET and may be prefetched by the mail
+// scanners, so the link should point to a landing page which asks the recipient to confirm and then calls the API by
+// NotificationClient.AcknowledgeNotificationByToken, e.g.
+// "Acknowledge: https://edgex.example.com/notifications/acknowledge?token={{ ackToken }}"
+func (t NotificationTemplate) RenderWithAckToken(channelType string, notification Notification, ackToken string) (RenderedNotification, errors.EdgeX) {
+	rendered := RenderedNotification{Content: notification.Content, ContentType: notification.ContentType}
+	name, text := "content", t.Content
+	switch channelType {
+	case common.EMAIL:
+		if t.EmailSubject != "" {
+			subject, err := renderNotificationTemplate("emailSubject", t.EmailSubject, notification, ackToken)
+			if err != nil {
+				return RenderedNotification{}, errors.NewCommonEdgeXWrapper(err)
+			}
+			rendered.Subject = subject
+		}
+		if t.EmailBody != "" {
+			name, text = "emailBody", t.EmailBody
+		}
+	case common.MQTT:
+		if t.MQTTPayload != "" {
+			payload, err := renderNotificationTemplate("mqttPayload", t.MQTTPayload, notification, ackToken)
+			if err != nil {
+				return RenderedNotification{}, errors.NewCommonEdgeXWrapper(err)
+			}
+			if !json.Valid([]byte(payload)) {
+				return RenderedNotification{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("the MQTT payload rendered by the notification template '%s' is not valid JSON", t.Name), nil)
+			}
+			rendered.Content = payload
+			rendered.ContentType = common.ContentTypeJSON
+			return rendered, nil
+		}
+	}
+	if text == "" {
+		return rendered, nil
+	}
+	content, err := renderNotificationTemplate(name, text, notification, ackToken)
+	if err != nil {
+		return RenderedNotification{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	rendered.Content = content
+	return rendered, nil
+}
+
+// parseNotificationTemplate parses the template text with the ack token, and referring to a field which doesn't
+// exist in the Notification is an error
+func parseNotificationTemplate(name, text, ackToken string) (*template.Template, errors.EdgeX) {
+	tmpl, err := template.New(name).Funcs(notificationTemplateFuncs).
+		Funcs(template.FuncMap{"ackToken": ackTokenFunc(ackToken)}).
+		Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s template", name), err)
+	}
+	if edgexErr := checkNotificationTemplateFields(tmpl); edgexErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s template", name), edgexErr)
+	}
+	return tmpl, nil
+}
+
+// renderNotificationTemplate parses and executes the template text with the notification and the ack token
+func renderNotificationTemplate(name, text string, notification Notification, ackToken string) (string, errors.EdgeX) {
+	tmpl, err := parseNotificationTemplate(name, text, ackToken)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, notification); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to render the %s template", name), err)
+	}
+	return buf.String(), nil
+}
+
+// ToNotificationTemplateModel transforms the NotificationTemplate DTO to the NotificationTemplate Model
+func ToNotificationTemplateModel(t NotificationTemplate) models.NotificationTemplate {
+	return models.NotificationTemplate{
+		DBTimestamp:  models.DBTimestamp(t.DBTimestamp),
+		Id:           t.Id,
+		Name:         t.Name,
+		Description:  t.Description,
+		Content:      t.Content,
+		EmailSubject: t.EmailSubject,
+		EmailBody:    t.EmailBody,
+		MQTTPayload:  t.MQTTPayload,
+	}
+}
+
+// FromNotificationTemplateModelToDTO transforms the NotificationTemplate Model to the NotificationTemplate DTO
+func FromNotificationTemplateModelToDTO(t models.NotificationTemplate) NotificationTemplate {
+	return NotificationTemplate{
+		DBTimestamp:  DBTimestamp(t.DBTimestamp),
+		Id:           t.Id,
+		Name:         t.Name,
+		Description:  t.Description,
+		Content:      t.Content,
+		EmailSubject: t.EmailSubject,
+		EmailBody:    t.EmailBody,
+		MQTTPayload:  t.MQTTPayload,
+	}
+}
+
+// ToNotificationTemplateModels transforms the NotificationTemplate DTO array to the NotificationTemplate model array
+func ToNotificationTemplateModels(templates []NotificationTemplate) []models.NotificationTemplate {
+	models := make([]models.NotificationTemplate, len(templates))
+	for i, t := range templates {
+		models[i] = ToNotificationTemplateModel(t)
+	}
+	return models
+}
+
+// FromNotificationTemplateModelsToDTOs transforms the NotificationTemplate model array to the NotificationTemplate DTO array
+func FromNotificationTemplateModelsToDTOs(templates []models.NotificationTemplate) []NotificationTemplate {
+	dtos := make([]NotificationTemplate, len(templates))
+	for i, t := range templates {
+		dtos[i] = FromNotificationTemplateModelToDTO(t)
+	}
+	return dtos
+}
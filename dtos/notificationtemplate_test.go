@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+const testNotificationTemplateName = "device-alert"
+
+func notificationTemplateData() NotificationTemplate {
+	return NotificationTemplate{
+		Id:           TestUUID,
+		Name:         testNotificationTemplateName,
+		Description:  "alert of the devices",
+		Content:      "[{{ .Severity }}] {{ .Content }}",
+		EmailSubject: "{{ .Severity }} {{ .Category }} from {{ .Sender }}",
+		EmailBody:    "{{ .Content }}\nLabels: {{ join .Labels \", \" }}",
+		MQTTPayload:  `{"severity":"{{ .Severity }}","content":{{ json .Content }},"labels":{{ json .Labels }}}`,
+	}
+}
+
+func TestNotificationTemplate_Validate(t *testing.T) {
+	valid := notificationTemplateData()
+	validOnlyContent := NotificationTemplate{Name: testNotificationTemplateName, Content: "{{ .Content }}"}
+	noName := notificationTemplateData()
+	noName.Name = ""
+	contentIndexesLabels := notificationTemplateData()
+	contentIndexesLabels.Content = "[{{ index .Labels 0 }}] {{ .Content }}"
+	contentSyntaxError := notificationTemplateData()
+	contentSyntaxError.Content = "{{ .Content "
+	emailSubjectSyntaxError := notificationTemplateData()
+	emailSubjectSyntaxError.EmailSubject = "{{ if .Severity }}"
+	emailBodyUnknownField := notificationTemplateData()
+	emailBodyUnknownField.EmailBody = "{{ .Message }}"
+	mqttPayloadUnknownFunc := notificationTemplateData()
+	mqttPayloadUnknownFunc.MQTTPayload = `{"content":{{ yaml .Content }}}`
+
+	tests := []struct {
+		name        string
+		template    NotificationTemplate
+		expectError bool
+	}{
+		{"valid", valid, false},
+		{"valid, only content", validOnlyContent, false},
+		{"valid, no name", noName, false},
+		{"valid, content indexes the labels", contentIndexesLabels, false},
+		{"invalid, content syntax error", contentSyntaxError, true},
+		{"invalid, email subject syntax error", emailSubjectSyntaxError, true},
+		{"invalid, email body refers to unknown field", emailBodyUnknownField, true},
+		{"invalid, MQTT payload calls unknown function", mqttPayloadUnknownFunc, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected NotificationTemplate validation result.", err)
+		})
+	}
+}
+
+func TestNotificationTemplate_Render(t *testing.T) {
+	notification := Notification{
+		Category:    "health-check",
+		Labels:      []string{"device", "offline"},
+		Content:     `Device "pump-1" is offline`,
+		ContentType: common.ContentTypeText,
+		Sender:      "core-metadata",
+		Severity:    models.Critical,
+	}
+	invalidPayload := notificationTemplateData()
+	invalidPayload.MQTTPayload = `{"content":{{ .Content }}}`
+
+	tests := []struct {
+		name        string
+		template    NotificationTemplate
+		channelType string
+		expected    RenderedNotification
+		expectError bool
+	}{
+		{"email", notificationTemplateData(), common.EMAIL, RenderedNotification{
+			Subject:     "CRITICAL health-check from core-metadata",
+			Content:     "Device \"pump-1\" is offline\nLabels: device, offline",
+			ContentType: common.ContentTypeText,
+		}, false},
+		{"MQTT", notificationTemplateData(), common.MQTT, RenderedNotification{
+			Content:     `{"severity":"CRITICAL","content":"Device \"pump-1\" is offline","labels":["device","offline"]}`,
+			ContentType: common.ContentTypeJSON,
+		}, false},
+		{"REST falls back to content variant", notificationTemplateData(), common.REST, RenderedNotification{
+			Content:     `[CRITICAL] Device "pump-1" is offline`,
+			ContentType: common.ContentTypeText,
+		}, false},
+		{"email falls back to content variant", NotificationTemplate{Name: testNotificationTemplateName, Content: "{{ .Sender }}"},
+			common.EMAIL, RenderedNotification{Content: "core-metadata", ContentType: common.ContentTypeText}, false},
+		{"no variant", NotificationTemplate{Name: testNotificationTemplateName}, common.ZeroMQ,
+			RenderedNotification{Content: notification.Content, ContentType: common.ContentTypeText}, false},
+		{"MQTT payload is not JSON", invalidPayload, common.MQTT, RenderedNotification{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := tt.template.Render(tt.channelType, notification)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+}
+
+func TestNotificationTemplateModelConversion(t *testing.T) {
+	dto := notificationTemplateData()
+	model := ToNotificationTemplateModel(dto)
+	assert.Equal(t, dto.MQTTPayload, model.MQTTPayload)
+	assert.Equal(t, dto, FromNotificationTemplateModelToDTO(model))
+
+	subscription := Subscription{Name: "subscription", Template: &dto}
+	subscriptionModel := ToSubscriptionModel(subscription)
+	require.NotNil(t, subscriptionModel.Template)
+	assert.Equal(t, model, *subscriptionModel.Template)
+	assert.Equal(t, &dto, FromSubscriptionModelToDTO(subscriptionModel).Template)
+
+	subscription = Subscription{Name: "subscription", TemplateName: testNotificationTemplateName}
+	subscriptionModel = ToSubscriptionModel(subscription)
+	assert.Equal(t, testNotificationTemplateName, subscriptionModel.TemplateName)
+	assert.Equal(t, testNotificationTemplateName, FromSubscriptionModelToDTO(subscriptionModel).TemplateName)
+}
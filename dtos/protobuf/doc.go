@@ -0,0 +1,10 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package protobuf contains the generated protobuf codecs for the Event and AddEventRequest DTOs,
+// which are used when the payload is encoded with the application/x-protobuf content type.
+package protobuf
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative event.proto
@@ -0,0 +1,1124 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: event.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Value is a dynamically typed value of the tags, extensions and object readings. Unlike the
+// google.protobuf.Value, the integers are kept apart from the doubles so that the int64 and uint64
+// values keep their precision. The null value is a Value without any kind set.
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Value_DoubleValue
+	//	*Value_IntValue
+	//	*Value_UintValue
+	//	*Value_StringValue
+	//	*Value_BoolValue
+	//	*Value_BytesValue
+	//	*Value_ListValue
+	//	*Value_MapValue
+	Kind          isValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Value) GetKind() isValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Value) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetUintValue() uint64 {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_UintValue); ok {
+			return x.UintValue
+		}
+	}
+	return 0
+}
+
+func (x *Value) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *Value) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetListValue() *ListValue {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_ListValue); ok {
+			return x.ListValue
+		}
+	}
+	return nil
+}
+
+func (x *Value) GetMapValue() *MapValue {
+	if x != nil {
+		if x, ok := x.Kind.(*Value_MapValue); ok {
+			return x.MapValue
+		}
+	}
+	return nil
+}
+
+type isValue_Kind interface {
+	isValue_Kind()
+}
+
+type Value_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,1,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type Value_IntValue struct {
+	IntValue int64 `protobuf:"zigzag64,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type Value_UintValue struct {
+	UintValue uint64 `protobuf:"varint,3,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type Value_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Value_BoolValue struct {
+	BoolValue bool `protobuf:"varint,5,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type Value_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,6,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type Value_ListValue struct {
+	ListValue *ListValue `protobuf:"bytes,7,opt,name=list_value,json=listValue,proto3,oneof"`
+}
+
+type Value_MapValue struct {
+	MapValue *MapValue `protobuf:"bytes,8,opt,name=map_value,json=mapValue,proto3,oneof"`
+}
+
+func (*Value_DoubleValue) isValue_Kind() {}
+
+func (*Value_IntValue) isValue_Kind() {}
+
+func (*Value_UintValue) isValue_Kind() {}
+
+func (*Value_StringValue) isValue_Kind() {}
+
+func (*Value_BoolValue) isValue_Kind() {}
+
+func (*Value_BytesValue) isValue_Kind() {}
+
+func (*Value_ListValue) isValue_Kind() {}
+
+func (*Value_MapValue) isValue_Kind() {}
+
+// ListValue is a list of dynamically typed values.
+type ListValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*Value               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListValue) Reset() {
+	*x = ListValue{}
+	mi := &file_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValue) ProtoMessage() {}
+
+func (x *ListValue) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValue.ProtoReflect.Descriptor instead.
+func (*ListValue) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListValue) GetValues() []*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// MapValue is a map of dynamically typed values with string keys.
+type MapValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]*Value      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapValue) Reset() {
+	*x = MapValue{}
+	mi := &file_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapValue) ProtoMessage() {}
+
+func (x *MapValue) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapValue.ProtoReflect.Descriptor instead.
+func (*MapValue) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MapValue) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Tags carries the user-defined tags attached to an Event or a Reading.
+type Tags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]*Value      `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tags) Reset() {
+	*x = Tags{}
+	mi := &file_event_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tags) ProtoMessage() {}
+
+func (x *Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tags.ProtoReflect.Descriptor instead.
+func (*Tags) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Tags) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Event is the protobuf wire representation of the dtos.Event.
+type Event struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion  string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceName  string                 `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	ProfileName string                 `protobuf:"bytes,4,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	SourceName  string                 `protobuf:"bytes,5,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	Origin      int64                  `protobuf:"varint,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Readings    []*BaseReading         `protobuf:"bytes,7,rep,name=readings,proto3" json:"readings,omitempty"`
+	Tags        *Tags                  `protobuf:"bytes,8,opt,name=tags,proto3" json:"tags,omitempty"`
+	// extensions holds the top-level keys which are not part of the Event contract
+	Extensions    *MapValue `protobuf:"bytes,9,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Event) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *Event) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *Event) GetSourceName() string {
+	if x != nil {
+		return x.SourceName
+	}
+	return ""
+}
+
+func (x *Event) GetOrigin() int64 {
+	if x != nil {
+		return x.Origin
+	}
+	return 0
+}
+
+func (x *Event) GetReadings() []*BaseReading {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+func (x *Event) GetTags() *Tags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Event) GetExtensions() *MapValue {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// BaseReading is the protobuf wire representation of the dtos.BaseReading.
+// Exactly one of the reading variants is set, according to the value type.
+type BaseReading struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Origin       int64                  `protobuf:"varint,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	DeviceName   string                 `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	ResourceName string                 `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	ProfileName  string                 `protobuf:"bytes,5,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	ValueType    string                 `protobuf:"bytes,6,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	Units        string                 `protobuf:"bytes,7,opt,name=units,proto3" json:"units,omitempty"`
+	Tags         *Tags                  `protobuf:"bytes,8,opt,name=tags,proto3" json:"tags,omitempty"`
+	// extensions holds the top-level keys which are not part of the Reading contract
+	Extensions *MapValue `protobuf:"bytes,9,opt,name=extensions,proto3" json:"extensions,omitempty"`
+	// Types that are valid to be assigned to Reading:
+	//
+	//	*BaseReading_SimpleReading
+	//	*BaseReading_BinaryReading
+	//	*BaseReading_ObjectReading
+	//	*BaseReading_NullReading
+	//	*BaseReading_NumericReading
+	Reading       isBaseReading_Reading `protobuf_oneof:"reading"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseReading) Reset() {
+	*x = BaseReading{}
+	mi := &file_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseReading) ProtoMessage() {}
+
+func (x *BaseReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseReading.ProtoReflect.Descriptor instead.
+func (*BaseReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BaseReading) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BaseReading) GetOrigin() int64 {
+	if x != nil {
+		return x.Origin
+	}
+	return 0
+}
+
+func (x *BaseReading) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *BaseReading) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *BaseReading) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *BaseReading) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *BaseReading) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *BaseReading) GetTags() *Tags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BaseReading) GetExtensions() *MapValue {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *BaseReading) GetReading() isBaseReading_Reading {
+	if x != nil {
+		return x.Reading
+	}
+	return nil
+}
+
+func (x *BaseReading) GetSimpleReading() *SimpleReading {
+	if x != nil {
+		if x, ok := x.Reading.(*BaseReading_SimpleReading); ok {
+			return x.SimpleReading
+		}
+	}
+	return nil
+}
+
+func (x *BaseReading) GetBinaryReading() *BinaryReading {
+	if x != nil {
+		if x, ok := x.Reading.(*BaseReading_BinaryReading); ok {
+			return x.BinaryReading
+		}
+	}
+	return nil
+}
+
+func (x *BaseReading) GetObjectReading() *ObjectReading {
+	if x != nil {
+		if x, ok := x.Reading.(*BaseReading_ObjectReading); ok {
+			return x.ObjectReading
+		}
+	}
+	return nil
+}
+
+func (x *BaseReading) GetNullReading() *NullReading {
+	if x != nil {
+		if x, ok := x.Reading.(*BaseReading_NullReading); ok {
+			return x.NullReading
+		}
+	}
+	return nil
+}
+
+func (x *BaseReading) GetNumericReading() *NumericReading {
+	if x != nil {
+		if x, ok := x.Reading.(*BaseReading_NumericReading); ok {
+			return x.NumericReading
+		}
+	}
+	return nil
+}
+
+type isBaseReading_Reading interface {
+	isBaseReading_Reading()
+}
+
+type BaseReading_SimpleReading struct {
+	SimpleReading *SimpleReading `protobuf:"bytes,10,opt,name=simple_reading,json=simpleReading,proto3,oneof"`
+}
+
+type BaseReading_BinaryReading struct {
+	BinaryReading *BinaryReading `protobuf:"bytes,11,opt,name=binary_reading,json=binaryReading,proto3,oneof"`
+}
+
+type BaseReading_ObjectReading struct {
+	ObjectReading *ObjectReading `protobuf:"bytes,12,opt,name=object_reading,json=objectReading,proto3,oneof"`
+}
+
+type BaseReading_NullReading struct {
+	NullReading *NullReading `protobuf:"bytes,13,opt,name=null_reading,json=nullReading,proto3,oneof"`
+}
+
+type BaseReading_NumericReading struct {
+	NumericReading *NumericReading `protobuf:"bytes,14,opt,name=numeric_reading,json=numericReading,proto3,oneof"`
+}
+
+func (*BaseReading_SimpleReading) isBaseReading_Reading() {}
+
+func (*BaseReading_BinaryReading) isBaseReading_Reading() {}
+
+func (*BaseReading_ObjectReading) isBaseReading_Reading() {}
+
+func (*BaseReading_NullReading) isBaseReading_Reading() {}
+
+func (*BaseReading_NumericReading) isBaseReading_Reading() {}
+
+type SimpleReading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimpleReading) Reset() {
+	*x = SimpleReading{}
+	mi := &file_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimpleReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimpleReading) ProtoMessage() {}
+
+func (x *SimpleReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimpleReading.ProtoReflect.Descriptor instead.
+func (*SimpleReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SimpleReading) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type BinaryReading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BinaryValue   []byte                 `protobuf:"bytes,1,opt,name=binary_value,json=binaryValue,proto3" json:"binary_value,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryReading) Reset() {
+	*x = BinaryReading{}
+	mi := &file_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryReading) ProtoMessage() {}
+
+func (x *BinaryReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryReading.ProtoReflect.Descriptor instead.
+func (*BinaryReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BinaryReading) GetBinaryValue() []byte {
+	if x != nil {
+		return x.BinaryValue
+	}
+	return nil
+}
+
+func (x *BinaryReading) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type ObjectReading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectValue   *Value                 `protobuf:"bytes,1,opt,name=object_value,json=objectValue,proto3" json:"object_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectReading) Reset() {
+	*x = ObjectReading{}
+	mi := &file_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectReading) ProtoMessage() {}
+
+func (x *ObjectReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectReading.ProtoReflect.Descriptor instead.
+func (*ObjectReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ObjectReading) GetObjectValue() *Value {
+	if x != nil {
+		return x.ObjectValue
+	}
+	return nil
+}
+
+// NullReading indicates the reading value is null.
+type NullReading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NullReading) Reset() {
+	*x = NullReading{}
+	mi := &file_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NullReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NullReading) ProtoMessage() {}
+
+func (x *NullReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NullReading.ProtoReflect.Descriptor instead.
+func (*NullReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+type NumericReading struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumericValue  *Value                 `protobuf:"bytes,1,opt,name=numeric_value,json=numericValue,proto3" json:"numeric_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumericReading) Reset() {
+	*x = NumericReading{}
+	mi := &file_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumericReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericReading) ProtoMessage() {}
+
+func (x *NumericReading) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericReading.ProtoReflect.Descriptor instead.
+func (*NumericReading) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NumericReading) GetNumericValue() *Value {
+	if x != nil {
+		return x.NumericValue
+	}
+	return nil
+}
+
+// AddEventRequest is the protobuf wire representation of the requests.AddEventRequest.
+type AddEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion    string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Event         *Event                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
+	mi := &file_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventRequest) ProtoMessage() {}
+
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddEventRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AddEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+var File_event_proto protoreflect.FileDescriptor
+
+const file_event_proto_rawDesc = "" +
+	"\n" +
+	"\vevent.proto\x12\n" +
+	"edgex.dtos\"\xca\x02\n" +
+	"\x05Value\x12#\n" +
+	"\fdouble_value\x18\x01 \x01(\x01H\x00R\vdoubleValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x12H\x00R\bintValue\x12\x1f\n" +
+	"\n" +
+	"uint_value\x18\x03 \x01(\x04H\x00R\tuintValue\x12#\n" +
+	"\fstring_value\x18\x04 \x01(\tH\x00R\vstringValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x05 \x01(\bH\x00R\tboolValue\x12!\n" +
+	"\vbytes_value\x18\x06 \x01(\fH\x00R\n" +
+	"bytesValue\x126\n" +
+	"\n" +
+	"list_value\x18\a \x01(\v2\x15.edgex.dtos.ListValueH\x00R\tlistValue\x123\n" +
+	"\tmap_value\x18\b \x01(\v2\x14.edgex.dtos.MapValueH\x00R\bmapValueB\x06\n" +
+	"\x04kind\"6\n" +
+	"\tListValue\x12)\n" +
+	"\x06values\x18\x01 \x03(\v2\x11.edgex.dtos.ValueR\x06values\"\x92\x01\n" +
+	"\bMapValue\x128\n" +
+	"\x06values\x18\x01 \x03(\v2 .edgex.dtos.MapValue.ValuesEntryR\x06values\x1aL\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.edgex.dtos.ValueR\x05value:\x028\x01\"\x8a\x01\n" +
+	"\x04Tags\x124\n" +
+	"\x06values\x18\x01 \x03(\v2\x1c.edgex.dtos.Tags.ValuesEntryR\x06values\x1aL\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.edgex.dtos.ValueR\x05value:\x028\x01\"\xc6\x02\n" +
+	"\x05Event\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12!\n" +
+	"\fprofile_name\x18\x04 \x01(\tR\vprofileName\x12\x1f\n" +
+	"\vsource_name\x18\x05 \x01(\tR\n" +
+	"sourceName\x12\x16\n" +
+	"\x06origin\x18\x06 \x01(\x03R\x06origin\x123\n" +
+	"\breadings\x18\a \x03(\v2\x17.edgex.dtos.BaseReadingR\breadings\x12$\n" +
+	"\x04tags\x18\b \x01(\v2\x10.edgex.dtos.TagsR\x04tags\x124\n" +
+	"\n" +
+	"extensions\x18\t \x01(\v2\x14.edgex.dtos.MapValueR\n" +
+	"extensions\"\x8b\x05\n" +
+	"\vBaseReading\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06origin\x18\x02 \x01(\x03R\x06origin\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName\x12!\n" +
+	"\fprofile_name\x18\x05 \x01(\tR\vprofileName\x12\x1d\n" +
+	"\n" +
+	"value_type\x18\x06 \x01(\tR\tvalueType\x12\x14\n" +
+	"\x05units\x18\a \x01(\tR\x05units\x12$\n" +
+	"\x04tags\x18\b \x01(\v2\x10.edgex.dtos.TagsR\x04tags\x124\n" +
+	"\n" +
+	"extensions\x18\t \x01(\v2\x14.edgex.dtos.MapValueR\n" +
+	"extensions\x12B\n" +
+	"\x0esimple_reading\x18\n" +
+	" \x01(\v2\x19.edgex.dtos.SimpleReadingH\x00R\rsimpleReading\x12B\n" +
+	"\x0ebinary_reading\x18\v \x01(\v2\x19.edgex.dtos.BinaryReadingH\x00R\rbinaryReading\x12B\n" +
+	"\x0eobject_reading\x18\f \x01(\v2\x19.edgex.dtos.ObjectReadingH\x00R\robjectReading\x12<\n" +
+	"\fnull_reading\x18\r \x01(\v2\x17.edgex.dtos.NullReadingH\x00R\vnullReading\x12E\n" +
+	"\x0fnumeric_reading\x18\x0e \x01(\v2\x1a.edgex.dtos.NumericReadingH\x00R\x0enumericReadingB\t\n" +
+	"\areading\"%\n" +
+	"\rSimpleReading\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"Q\n" +
+	"\rBinaryReading\x12!\n" +
+	"\fbinary_value\x18\x01 \x01(\fR\vbinaryValue\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\tmediaType\"E\n" +
+	"\rObjectReading\x124\n" +
+	"\fobject_value\x18\x01 \x01(\v2\x11.edgex.dtos.ValueR\vobjectValue\"\r\n" +
+	"\vNullReading\"H\n" +
+	"\x0eNumericReading\x126\n" +
+	"\rnumeric_value\x18\x01 \x01(\v2\x11.edgex.dtos.ValueR\fnumericValue\"z\n" +
+	"\x0fAddEventRequest\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12'\n" +
+	"\x05event\x18\x03 \x01(\v2\x11.edgex.dtos.EventR\x05eventB@Z>github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/protobufb\x06proto3"
+
+var (
+	file_event_proto_rawDescOnce sync.Once
+	file_event_proto_rawDescData []byte
+)
+
+func file_event_proto_rawDescGZIP() []byte {
+	file_event_proto_rawDescOnce.Do(func() {
+		file_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)))
+	})
+	return file_event_proto_rawDescData
+}
+
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_event_proto_goTypes = []any{
+	(*Value)(nil),           // 0: edgex.dtos.Value
+	(*ListValue)(nil),       // 1: edgex.dtos.ListValue
+	(*MapValue)(nil),        // 2: edgex.dtos.MapValue
+	(*Tags)(nil),            // 3: edgex.dtos.Tags
+	(*Event)(nil),           // 4: edgex.dtos.Event
+	(*BaseReading)(nil),     // 5: edgex.dtos.BaseReading
+	(*SimpleReading)(nil),   // 6: edgex.dtos.SimpleReading
+	(*BinaryReading)(nil),   // 7: edgex.dtos.BinaryReading
+	(*ObjectReading)(nil),   // 8: edgex.dtos.ObjectReading
+	(*NullReading)(nil),     // 9: edgex.dtos.NullReading
+	(*NumericReading)(nil),  // 10: edgex.dtos.NumericReading
+	(*AddEventRequest)(nil), // 11: edgex.dtos.AddEventRequest
+	nil,                     // 12: edgex.dtos.MapValue.ValuesEntry
+	nil,                     // 13: edgex.dtos.Tags.ValuesEntry
+}
+var file_event_proto_depIdxs = []int32{
+	1,  // 0: edgex.dtos.Value.list_value:type_name -> edgex.dtos.ListValue
+	2,  // 1: edgex.dtos.Value.map_value:type_name -> edgex.dtos.MapValue
+	0,  // 2: edgex.dtos.ListValue.values:type_name -> edgex.dtos.Value
+	12, // 3: edgex.dtos.MapValue.values:type_name -> edgex.dtos.MapValue.ValuesEntry
+	13, // 4: edgex.dtos.Tags.values:type_name -> edgex.dtos.Tags.ValuesEntry
+	5,  // 5: edgex.dtos.Event.readings:type_name -> edgex.dtos.BaseReading
+	3,  // 6: edgex.dtos.Event.tags:type_name -> edgex.dtos.Tags
+	2,  // 7: edgex.dtos.Event.extensions:type_name -> edgex.dtos.MapValue
+	3,  // 8: edgex.dtos.BaseReading.tags:type_name -> edgex.dtos.Tags
+	2,  // 9: edgex.dtos.BaseReading.extensions:type_name -> edgex.dtos.MapValue
+	6,  // 10: edgex.dtos.BaseReading.simple_reading:type_name -> edgex.dtos.SimpleReading
+	7,  // 11: edgex.dtos.BaseReading.binary_reading:type_name -> edgex.dtos.BinaryReading
+	8,  // 12: edgex.dtos.BaseReading.object_reading:type_name -> edgex.dtos.ObjectReading
+	9,  // 13: edgex.dtos.BaseReading.null_reading:type_name -> edgex.dtos.NullReading
+	10, // 14: edgex.dtos.BaseReading.numeric_reading:type_name -> edgex.dtos.NumericReading
+	0,  // 15: edgex.dtos.ObjectReading.object_value:type_name -> edgex.dtos.Value
+	0,  // 16: edgex.dtos.NumericReading.numeric_value:type_name -> edgex.dtos.Value
+	4,  // 17: edgex.dtos.AddEventRequest.event:type_name -> edgex.dtos.Event
+	0,  // 18: edgex.dtos.MapValue.ValuesEntry.value:type_name -> edgex.dtos.Value
+	0,  // 19: edgex.dtos.Tags.ValuesEntry.value:type_name -> edgex.dtos.Value
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_event_proto_init() }
+func file_event_proto_init() {
+	if File_event_proto != nil {
+		return
+	}
+	file_event_proto_msgTypes[0].OneofWrappers = []any{
+		(*Value_DoubleValue)(nil),
+		(*Value_IntValue)(nil),
+		(*Value_UintValue)(nil),
+		(*Value_StringValue)(nil),
+		(*Value_BoolValue)(nil),
+		(*Value_BytesValue)(nil),
+		(*Value_ListValue)(nil),
+		(*Value_MapValue)(nil),
+	}
+	file_event_proto_msgTypes[5].OneofWrappers = []any{
+		(*BaseReading_SimpleReading)(nil),
+		(*BaseReading_BinaryReading)(nil),
+		(*BaseReading_ObjectReading)(nil),
+		(*BaseReading_NullReading)(nil),
+		(*BaseReading_NumericReading)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_event_proto_goTypes,
+		DependencyIndexes: file_event_proto_depIdxs,
+		MessageInfos:      file_event_proto_msgTypes,
+	}.Build()
+	File_event_proto = out.File
+	file_event_proto_goTypes = nil
+	file_event_proto_depIdxs = nil
+}
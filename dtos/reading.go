@@ -579,7 +579,7 @@ func (b BaseReading) MarshalJSON() ([]byte, error) {
 	if err != nil || len(b.Extensions) == 0 {
 		return data, err
 	}
-	return mergeExtensions(data, b.Extensions, json.Unmarshal, json.Marshal)
+	return mergeExtensions(data, b.Extensions, jsonUnmarshalUseNumber, json.Marshal)
 }
 
 func (b BaseReading) MarshalCBOR() ([]byte, error) {
@@ -650,22 +650,9 @@ func (b BaseReading) marshal(marshal func(any) ([]byte, error)) ([]byte, error)
 			reading:       r,
 			BinaryReading: b.BinaryReading,
 		})
-	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
-		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
-		common.ValueTypeFloat32, common.ValueTypeFloat64,
-		common.ValueTypeInt8Array, common.ValueTypeInt16Array, common.ValueTypeInt32Array, common.ValueTypeInt64Array,
-		common.ValueTypeUint8Array, common.ValueTypeUint16Array, common.ValueTypeUint32Array, common.ValueTypeUint64Array,
-		common.ValueTypeFloat32Array, common.ValueTypeFloat64Array:
-		// the reading value should be stored in SimpleReading or NumericReading
-		if b.NumericValue == nil {
-			return marshal(&struct {
-				reading       `json:",inline"`
-				SimpleReading `json:",inline" validate:"-"`
-			}{
-				reading:       r,
-				SimpleReading: b.SimpleReading,
-			})
-		} else {
+	default:
+		// the numeric reading value should be stored in SimpleReading or NumericReading
+		if isNumericValueType(b.ValueType) && b.NumericValue != nil {
 			type numericReading struct { // define another struct to output the numeric reading value
 				Value any `json:"value"`
 			}
@@ -677,7 +664,6 @@ func (b BaseReading) marshal(marshal func(any) ([]byte, error)) ([]byte, error)
 				numericReading: numericReading{Value: b.NumericValue},
 			})
 		}
-	default:
 		return marshal(&struct {
 			reading       `json:",inline"`
 			SimpleReading `json:",inline" validate:"-"`
@@ -688,6 +674,21 @@ func (b BaseReading) marshal(marshal func(any) ([]byte, error)) ([]byte, error)
 	}
 }
 
+// isNumericValueType checks whether the reading value of the valueType can be represented by the NumericReading
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64,
+		common.ValueTypeInt8Array, common.ValueTypeInt16Array, common.ValueTypeInt32Array, common.ValueTypeInt64Array,
+		common.ValueTypeUint8Array, common.ValueTypeUint16Array, common.ValueTypeUint32Array, common.ValueTypeUint64Array,
+		common.ValueTypeFloat32Array, common.ValueTypeFloat64Array:
+		return true
+	default:
+		return false
+	}
+}
+
 func (b *BaseReading) UnmarshalJSON(data []byte) error {
 	return b.Unmarshal(data, jsonUnmarshalUseNumber)
 }
@@ -750,6 +751,12 @@ func (b *BaseReading) populateFromMap(rawMap map[string]any) error {
 		return fmt.Errorf("failed to decode origin: unsupported type %T", v)
 	}
 
+	// decode the registered extensions before convertJSONNumbers to keep the numeric precision
+	typedExtensions, err := popRegisteredExtensions(rawMap, ExtensionScopeReading)
+	if err != nil {
+		return err
+	}
+
 	// convert json.Number in rawMap to native numeric types before assigning Tags/Extensions
 	convertJSONNumbers(rawMap)
 
@@ -813,5 +820,8 @@ func (b *BaseReading) populateFromMap(rawMap map[string]any) error {
 	} else {
 		b.Extensions = make(map[string]any)
 	}
+	for k, v := range typedExtensions {
+		b.Extensions[k] = v
+	}
 	return nil
 }
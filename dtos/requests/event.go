@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,15 +7,18 @@ package requests
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/protobuf"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/fxamacker/cbor/v2"
+	"google.golang.org/protobuf/proto"
 )
 
 // AddEventRequest defines the Request Content for POST event DTO.
@@ -71,7 +74,31 @@ func (a *AddEventRequest) Unmarshal(b []byte, f unmarshal) error {
 	}
 
 	*a = AddEventRequest(addEvent)
+	return a.validateAndNormalize()
+}
+
+// UnmarshalProtobuf decodes the protobuf wire format into the AddEventRequest
+func (a *AddEventRequest) UnmarshalProtobuf(b []byte) error {
+	var addEvent protobuf.AddEventRequest
+	if err := proto.Unmarshal(b, &addEvent); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal the byte array.", err)
+	}
+
+	event, err := dtos.FromEventProtobufToDTO(addEvent.GetEvent())
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal the byte array.", err)
+	}
+	*a = AddEventRequest{
+		BaseRequest: dtoCommon.BaseRequest{
+			Versionable: dtoCommon.Versionable{ApiVersion: addEvent.GetApiVersion()},
+			RequestId:   addEvent.GetRequestId(),
+		},
+		Event: event,
+	}
+	return a.validateAndNormalize()
+}
 
+func (a *AddEventRequest) validateAndNormalize() error {
 	// validate AddEventRequest DTO
 	if err := a.Validate(); err != nil {
 		return err
@@ -88,29 +115,42 @@ func (a *AddEventRequest) Unmarshal(b []byte, f unmarshal) error {
 	return nil
 }
 
+// Encode encodes the AddEventRequest with the content type determined by GetEncodingContentType
 func (a *AddEventRequest) Encode() ([]byte, string, error) {
-	var encoding = a.GetEncodingContentType()
-	var err error
-	var encodedData []byte
+	return a.EncodeWithContentType(a.GetEncodingContentType())
+}
 
-	switch encoding {
-	case common.ContentTypeCBOR:
-		encodedData, err = cbor.Marshal(a)
-		if err != nil {
-			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode AddEventRequest to CBOR", err)
-		}
-	case common.ContentTypeJSON:
-		encodedData, err = json.Marshal(a)
-		if err != nil {
-			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode AddEventRequest to JSON", err)
-		}
+// EncodeWithContentType encodes the AddEventRequest with the Codec registered for the specified content type
+func (a *AddEventRequest) EncodeWithContentType(contentType string) ([]byte, string, error) {
+	codec, edgexErr := common.GetCodec(contentType)
+	if edgexErr != nil {
+		return nil, "", errors.NewCommonEdgeXWrapper(edgexErr)
 	}
+	encodedData, err := codec.Marshal(a)
+	if err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode AddEventRequest to %s", codec.ContentType()), err)
+	}
+	return encodedData, codec.ContentType(), nil
+}
 
-	return encodedData, encoding, nil
+// MarshalProtobuf encodes the AddEventRequest with the protobuf wire format
+func (a *AddEventRequest) MarshalProtobuf() ([]byte, error) {
+	event, err := dtos.ToEventProtobuf(a.Event)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&protobuf.AddEventRequest{
+		ApiVersion: a.ApiVersion,
+		RequestId:  a.RequestId,
+		Event:      event,
+	})
 }
 
 // GetEncodingContentType determines which content type should be used to encode and decode this object
 func (a *AddEventRequest) GetEncodingContentType() string {
+	if v := os.Getenv(common.EnvEncodeAllEventsProtobuf); v == common.ValueTrue {
+		return common.ContentTypeProtobuf
+	}
 	if v := os.Getenv(common.EnvEncodeAllEvents); v == common.ValueTrue {
 		return common.ContentTypeCBOR
 	}
@@ -291,6 +291,106 @@ func TestAddEvent_UnmarshalCBOR(t *testing.T) {
 	}
 }
 
+func TestAddEvent_UnmarshalProtobuf(t *testing.T) {
+	expected := eventRequestData()
+	expected.RequestId = ExampleUUID
+	validData, err := expected.MarshalProtobuf()
+	require.NoError(t, err)
+
+	validValueTypeLowerCase := eventRequestData()
+	validValueTypeLowerCase.RequestId = ExampleUUID
+	validValueTypeLowerCase.Event.Readings[0].ValueType = "uint8"
+	validValueTypeLowerCaseData, err := validValueTypeLowerCase.MarshalProtobuf()
+	require.NoError(t, err)
+
+	invalidNoEventId := eventRequestData()
+	invalidNoEventId.Event.Id = ""
+	invalidNoEventIdData, err := invalidNoEventId.MarshalProtobuf()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{"unmarshal AddEventRequest with success", validData, false},
+		{"unmarshal AddEventRequest with success, valid value type uint8", validValueTypeLowerCaseData, false},
+		{"unmarshal invalid AddEventRequest, empty data", []byte{}, true},
+		{"unmarshal invalid AddEventRequest, string data", []byte("Invalid AddEventRequest"), true},
+		{"unmarshal invalid AddEventRequest, no Event Id", invalidNoEventIdData, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var addEvent AddEventRequest
+			err := addEvent.UnmarshalProtobuf(tt.data)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, expected, addEvent, "Unmarshal did not result in expected AddEventRequest.")
+			}
+		})
+	}
+}
+
+func TestAddEventRequest_Encode(t *testing.T) {
+	request := eventRequestData()
+	binaryRequest := eventRequestData()
+	binaryRequest.Event.Readings[0] = dtos.NewBinaryReading(TestDeviceProfileName, TestDeviceName, TestDeviceResourceName, []byte(TestReadingBinaryValue), TestBinaryReadingMediaType)
+
+	tests := []struct {
+		name                string
+		request             AddEventRequest
+		envEncodeCBOR       string
+		envEncodeProtobuf   string
+		expectedContentType string
+	}{
+		{"encode with JSON", request, "", "", common.ContentTypeJSON},
+		{"encode with CBOR for binary reading", binaryRequest, "", "", common.ContentTypeCBOR},
+		{"encode with CBOR for all events", request, common.ValueTrue, "", common.ContentTypeCBOR},
+		{"encode with Protobuf for all events", request, "", common.ValueTrue, common.ContentTypeProtobuf},
+		{"encode with Protobuf for binary reading", binaryRequest, common.ValueTrue, common.ValueTrue, common.ContentTypeProtobuf},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(common.EnvEncodeAllEvents, tt.envEncodeCBOR)
+			t.Setenv(common.EnvEncodeAllEventsProtobuf, tt.envEncodeProtobuf)
+
+			data, contentType, err := tt.request.Encode()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContentType, contentType)
+
+			var decoded AddEventRequest
+			switch contentType {
+			case common.ContentTypeJSON:
+				err = decoded.UnmarshalJSON(data)
+			case common.ContentTypeCBOR:
+				err = decoded.UnmarshalCBOR(data)
+			case common.ContentTypeProtobuf:
+				err = decoded.UnmarshalProtobuf(data)
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.request.Event.Readings[0].ValueType, decoded.Event.Readings[0].ValueType)
+			assert.Equal(t, tt.request.Event.Id, decoded.Event.Id)
+		})
+	}
+}
+
+func TestAddEventRequest_EncodeWithContentType(t *testing.T) {
+	request := eventRequestData()
+
+	data, contentType, err := request.EncodeWithContentType(common.ContentTypeCBOR)
+	require.NoError(t, err)
+	assert.Equal(t, common.ContentTypeCBOR, contentType)
+	var decoded AddEventRequest
+	require.NoError(t, decoded.UnmarshalCBOR(data))
+	assert.Equal(t, request.Event.Id, decoded.Event.Id)
+
+	_, _, err = request.EncodeWithContentType(common.ContentTypeYAML)
+	require.Error(t, err)
+}
+
 func Test_AddEventReqToEventModels(t *testing.T) {
 	valid := eventRequestData()
 	s := models.SimpleReading{
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,6 +7,9 @@ package requests
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
@@ -68,16 +71,96 @@ type GetNotificationRequest struct {
 	QueryCondition        NotificationQueryCondition `json:"queryCondition"`
 }
 
+// NotificationQueryCondition defines the filters of the notifications. The filters are combined with AND, and a
+// notification matches a multi-valued filter if it matches any of the values. The unspecified filters match all.
 type NotificationQueryCondition struct {
 	Category []string `json:"category,omitempty"`
 	Start    int64    `json:"start,omitempty"`
 	End      int64    `json:"end,omitempty"`
+	Severity []string `json:"severity,omitempty" validate:"omitempty,dive,oneof='MINOR' 'NORMAL' 'CRITICAL'"`
+	Labels   []string `json:"labels,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	// LabelMatch specifies whether the notification should have ANY or ALL of the Labels, the default is ANY
+	LabelMatch string   `json:"labelMatch,omitempty" validate:"omitempty,oneof='ANY' 'ALL'"`
+	Sender     []string `json:"sender,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	Status     []string `json:"status,omitempty" validate:"omitempty,dive,oneof='NEW' 'PROCESSED' 'ESCALATED'"`
+	// Acknowledged filters by the acknowledgement status like the ack query parameter, and must not conflict with
+	// the ack query parameter when both are specified
+	Acknowledged *bool `json:"acknowledged,omitempty"`
+	// Sort specifies the order of the created timestamp, ASC or DESC, the default is DESC
+	Sort string `json:"sort,omitempty" validate:"omitempty,oneof='ASC' 'DESC'"`
 }
 
 // Validate satisfies the Validator interface
 func (request GetNotificationRequest) Validate() error {
 	err := common.Validate(request)
-	return err
+	if err != nil {
+		return err
+	}
+	condition := request.QueryCondition
+	if condition.Start > 0 && condition.End > 0 && condition.Start > condition.End {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the start %d of the query condition must not be greater than the end %d", condition.Start, condition.End), nil)
+	}
+	return nil
+}
+
+// ValidateAck checks that the ack query parameter, if specified, doesn't conflict with the Acknowledged filter of the
+// query condition, so that the acknowledgement status is filtered the same whichever is applied
+func (request GetNotificationRequest) ValidateAck(ack string) errors.EdgeX {
+	acknowledged := request.QueryCondition.Acknowledged
+	if ack == "" || acknowledged == nil {
+		return nil
+	}
+	ackValue, err := strconv.ParseBool(ack)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid ack query parameter '%s'", ack), err)
+	}
+	if ackValue != *acknowledged {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the ack query parameter '%s' conflicts with the acknowledged %t of the query condition", ack, *acknowledged), nil)
+	}
+	return nil
+}
+
+// Matches checks whether the notification satisfies the query condition. The Start and End bound the created
+// timestamp inclusively. The ack query parameter is not evaluated, since it is checked by ValidateAck not to
+// conflict with the Acknowledged filter and so filters the same acknowledgement status.
+func (c NotificationQueryCondition) Matches(n models.Notification) bool {
+	if len(c.Category) > 0 && !slices.Contains(c.Category, n.Category) {
+		return false
+	}
+	if (c.Start > 0 && n.Created < c.Start) || (c.End > 0 && n.Created > c.End) {
+		return false
+	}
+	if len(c.Severity) > 0 && !slices.Contains(c.Severity, string(n.Severity)) {
+		return false
+	}
+	if len(c.Sender) > 0 && !slices.Contains(c.Sender, n.Sender) {
+		return false
+	}
+	if len(c.Status) > 0 && !slices.Contains(c.Status, string(n.Status)) {
+		return false
+	}
+	if c.Acknowledged != nil && *c.Acknowledged != n.Acknowledged {
+		return false
+	}
+	if len(c.Labels) == 0 {
+		return true
+	}
+	if c.LabelMatch == common.LabelMatchAll {
+		for _, label := range c.Labels {
+			if !slices.Contains(n.Labels, label) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, label := range c.Labels {
+		if slices.Contains(n.Labels, label) {
+			return true
+		}
+	}
+	return false
 }
 
 // UnmarshalJSON implements the Unmarshaler interface for the GetNotificationRequest type
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2024 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -63,6 +64,19 @@ func TestAddNotification_Validate(t *testing.T) {
 	invalidStatus := buildTestAddNotificationRequest()
 	invalidStatus.Notification.Status = "foo"
 
+	validDedup := buildTestAddNotificationRequest()
+	validDedup.Notification.DedupKey = "pump-1-offline"
+	validDedup.Notification.SuppressionWindow = "10m"
+	validDedupKeyOnly := buildTestAddNotificationRequest()
+	validDedupKeyOnly.Notification.DedupKey = "pump-1-offline"
+	emptyDedupKey := buildTestAddNotificationRequest()
+	emptyDedupKey.Notification.DedupKey = " "
+	suppressionWindowWithoutDedupKey := buildTestAddNotificationRequest()
+	suppressionWindowWithoutDedupKey.Notification.SuppressionWindow = "10m"
+	invalidSuppressionWindow := buildTestAddNotificationRequest()
+	invalidSuppressionWindow.Notification.DedupKey = "pump-1-offline"
+	invalidSuppressionWindow.Notification.SuppressionWindow = "10"
+
 	tests := []struct {
 		name        string
 		request     AddNotificationRequest
@@ -77,6 +91,11 @@ func TestAddNotification_Validate(t *testing.T) {
 		{"invalid, no severity", noSeverity, true},
 		{"invalid, unsupported severity level", invalidSeverity, true},
 		{"invalid, unsupported status", invalidStatus, true},
+		{"valid, dedup key and suppression window", validDedup, false},
+		{"valid, only dedup key", validDedupKeyOnly, false},
+		{"invalid, empty dedup key", emptyDedupKey, true},
+		{"invalid, suppression window without dedup key", suppressionWindowWithoutDedupKey, true},
+		{"invalid, suppression window is not a duration", invalidSuppressionWindow, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -153,6 +172,30 @@ func TestGetNotificationRequest_Validate(t *testing.T) {
 	noCategory := buildTestGetNotificationRequest()
 	noCategory.QueryCondition.Category = []string{}
 
+	acknowledged := false
+	validAllFilters := buildTestGetNotificationRequest()
+	validAllFilters.QueryCondition.Severity = []string{models.Critical, models.Minor}
+	validAllFilters.QueryCondition.Labels = testNotificationLabels
+	validAllFilters.QueryCondition.LabelMatch = common.LabelMatchAll
+	validAllFilters.QueryCondition.Sender = []string{testNotificationSender}
+	validAllFilters.QueryCondition.Status = []string{models.New, models.Escalated}
+	validAllFilters.QueryCondition.Acknowledged = &acknowledged
+	validAllFilters.QueryCondition.Sort = common.SortOrderAsc
+	invalidSeverity := buildTestGetNotificationRequest()
+	invalidSeverity.QueryCondition.Severity = []string{"HIGH"}
+	emptyLabel := buildTestGetNotificationRequest()
+	emptyLabel.QueryCondition.Labels = []string{" "}
+	invalidLabelMatch := buildTestGetNotificationRequest()
+	invalidLabelMatch.QueryCondition.LabelMatch = "NONE"
+	emptySender := buildTestGetNotificationRequest()
+	emptySender.QueryCondition.Sender = []string{""}
+	invalidStatus := buildTestGetNotificationRequest()
+	invalidStatus.QueryCondition.Status = []string{models.Acknowledged}
+	invalidSort := buildTestGetNotificationRequest()
+	invalidSort.QueryCondition.Sort = "asc"
+	startAfterEnd := buildTestGetNotificationRequest()
+	startAfterEnd.QueryCondition.Start = 30
+
 	tests := []struct {
 		name        string
 		request     GetNotificationRequest
@@ -160,7 +203,15 @@ func TestGetNotificationRequest_Validate(t *testing.T) {
 	}{
 		{"valid", buildTestGetNotificationRequest(), false},
 		{"valid, no category", noCategory, false},
+		{"valid, all filters", validAllFilters, false},
 		{"invalid, request ID is not an UUID", invalidReqId, true},
+		{"invalid, unknown severity", invalidSeverity, true},
+		{"invalid, empty label", emptyLabel, true},
+		{"invalid, unknown label match", invalidLabelMatch, true},
+		{"invalid, empty sender", emptySender, true},
+		{"invalid, unknown status", invalidStatus, true},
+		{"invalid, unknown sort order", invalidSort, true},
+		{"invalid, start is after end", startAfterEnd, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -170,9 +221,48 @@ func TestGetNotificationRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestGetNotificationRequest_ValidateAck(t *testing.T) {
+	acknowledged := true
+	withAcknowledged := GetNotificationRequest{QueryCondition: NotificationQueryCondition{Acknowledged: &acknowledged}}
+	tests := []struct {
+		name        string
+		request     GetNotificationRequest
+		ack         string
+		expectedErr bool
+	}{
+		{"no ack", withAcknowledged, "", false},
+		{"no acknowledged", GetNotificationRequest{}, "false", false},
+		{"consistent", withAcknowledged, common.ValueTrue, false},
+		{"conflicting", withAcknowledged, common.ValueFalse, true},
+		{"invalid ack", withAcknowledged, "yes", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.ValidateAck(tt.ack)
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGetNotificationRequest_UnmarshalJSON(t *testing.T) {
 	getNotificationRequest := buildTestGetNotificationRequest()
 	jsonData, _ := json.Marshal(getNotificationRequest)
+	legacyRequest := GetNotificationRequest{
+		BaseRequest:    dtoCommon.BaseRequest{Versionable: dtoCommon.NewVersionable()},
+		QueryCondition: NotificationQueryCondition{Category: []string{testNotificationCategory}, Start: 10, End: 20},
+	}
+	legacyJsonData := []byte(`{"apiVersion":"v3","queryCondition":{"category":["category"],"start":10,"end":20}}`)
+	acknowledged := true
+	filterRequest := buildTestGetNotificationRequest()
+	filterRequest.QueryCondition.Severity = []string{models.Critical}
+	filterRequest.QueryCondition.Labels = testNotificationLabels
+	filterRequest.QueryCondition.LabelMatch = common.LabelMatchAny
+	filterRequest.QueryCondition.Acknowledged = &acknowledged
+	filterJsonData, _ := json.Marshal(filterRequest)
 	tests := []struct {
 		name     string
 		expected GetNotificationRequest
@@ -180,6 +270,10 @@ func TestGetNotificationRequest_UnmarshalJSON(t *testing.T) {
 		wantErr  bool
 	}{
 		{"unmarshal GetNotificationRequest with success", getNotificationRequest, jsonData, false},
+		{"unmarshal GetNotificationRequest with only legacy fields", legacyRequest, legacyJsonData, false},
+		{"unmarshal GetNotificationRequest with filters", filterRequest, filterJsonData, false},
+		{"unmarshal invalid GetNotificationRequest, unknown severity", GetNotificationRequest{},
+			[]byte(`{"apiVersion":"v3","queryCondition":{"severity":["HIGH"]}}`), true},
 		{"unmarshal invalid GetNotificationRequest, empty data", GetNotificationRequest{}, []byte{}, true},
 		{"unmarshal invalid GetNotificationRequest, string data", GetNotificationRequest{}, []byte("Invalid GetNotificationRequest"), true},
 	}
@@ -196,3 +290,45 @@ func TestGetNotificationRequest_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationQueryCondition_Matches(t *testing.T) {
+	notification := models.Notification{
+		DBTimestamp:  models.DBTimestamp{Created: 15},
+		Category:     testNotificationCategory,
+		Labels:       testNotificationLabels,
+		Sender:       testNotificationSender,
+		Severity:     models.Critical,
+		Status:       models.New,
+		Acknowledged: false,
+	}
+	acknowledged := true
+	unacknowledged := false
+
+	tests := []struct {
+		name      string
+		condition NotificationQueryCondition
+		expected  bool
+	}{
+		{"no filter", NotificationQueryCondition{}, true},
+		{"category and time range", NotificationQueryCondition{Category: []string{"other", testNotificationCategory}, Start: 10, End: 15}, true},
+		{"created before start", NotificationQueryCondition{Start: 16}, false},
+		{"created after end", NotificationQueryCondition{End: 14}, false},
+		{"severity", NotificationQueryCondition{Severity: []string{models.Critical}}, true},
+		{"other severity", NotificationQueryCondition{Severity: []string{models.Minor, models.Normal}}, false},
+		{"sender", NotificationQueryCondition{Sender: []string{testNotificationSender}}, true},
+		{"other sender", NotificationQueryCondition{Sender: []string{"core-metadata"}}, false},
+		{"status", NotificationQueryCondition{Status: []string{models.New}}, true},
+		{"other status", NotificationQueryCondition{Status: []string{models.Processed}}, false},
+		{"unacknowledged", NotificationQueryCondition{Acknowledged: &unacknowledged}, true},
+		{"acknowledged", NotificationQueryCondition{Acknowledged: &acknowledged}, false},
+		{"any label", NotificationQueryCondition{Labels: []string{"label2", "label3"}}, true},
+		{"no label matched", NotificationQueryCondition{Labels: []string{"label3"}, LabelMatch: common.LabelMatchAny}, false},
+		{"all labels", NotificationQueryCondition{Labels: testNotificationLabels, LabelMatch: common.LabelMatchAll}, true},
+		{"not all labels", NotificationQueryCondition{Labels: []string{"label1", "label3"}, LabelMatch: common.LabelMatchAll}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.condition.Matches(notification))
+		})
+	}
+}
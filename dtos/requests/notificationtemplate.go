@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// AddNotificationTemplateRequest defines the Request Content for POST NotificationTemplate DTO.
+type AddNotificationTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	NotificationTemplate  dtos.NotificationTemplate `json:"notificationTemplate"`
+}
+
+// Validate satisfies the Validator interface
+func (request AddNotificationTemplateRequest) Validate() error {
+	err := common.Validate(request)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = request.NotificationTemplate.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddNotificationTemplateRequest type
+func (request *AddNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		NotificationTemplate dtos.NotificationTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddNotificationTemplateRequest(alias)
+
+	// validate AddNotificationTemplateRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// AddNotificationTemplateReqToNotificationTemplateModels transforms the AddNotificationTemplateRequest DTO array to the NotificationTemplate model array
+func AddNotificationTemplateReqToNotificationTemplateModels(reqs []AddNotificationTemplateRequest) (t []models.NotificationTemplate) {
+	for _, req := range reqs {
+		t = append(t, dtos.ToNotificationTemplateModel(req.NotificationTemplate))
+	}
+	return t
+}
+
+// UpdateNotificationTemplateRequest defines the Request Content for PATCH NotificationTemplate DTO.
+type UpdateNotificationTemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	NotificationTemplate  dtos.UpdateNotificationTemplate `json:"notificationTemplate"`
+}
+
+// Validate satisfies the Validator interface
+func (request UpdateNotificationTemplateRequest) Validate() error {
+	err := common.Validate(request)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// validate the specified variants, and the unspecified or empty ones are skipped
+	patch := request.NotificationTemplate
+	var template dtos.NotificationTemplate
+	if patch.Name != nil {
+		template.Name = *patch.Name
+	}
+	if patch.Content != nil {
+		template.Content = *patch.Content
+	}
+	if patch.EmailSubject != nil {
+		template.EmailSubject = *patch.EmailSubject
+	}
+	if patch.EmailBody != nil {
+		template.EmailBody = *patch.EmailBody
+	}
+	if patch.MQTTPayload != nil {
+		template.MQTTPayload = *patch.MQTTPayload
+	}
+	if err = template.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateNotificationTemplateRequest type
+func (request *UpdateNotificationTemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		NotificationTemplate dtos.UpdateNotificationTemplate
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = UpdateNotificationTemplateRequest(alias)
+
+	// validate UpdateNotificationTemplateRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// ReplaceNotificationTemplateModelFieldsWithDTO replace existing NotificationTemplate's fields with DTO patch
+func ReplaceNotificationTemplateModelFieldsWithDTO(t *models.NotificationTemplate, patch dtos.UpdateNotificationTemplate) {
+	if patch.Description != nil {
+		t.Description = *patch.Description
+	}
+	if patch.Content != nil {
+		t.Content = *patch.Content
+	}
+	if patch.EmailSubject != nil {
+		t.EmailSubject = *patch.EmailSubject
+	}
+	if patch.EmailBody != nil {
+		t.EmailBody = *patch.EmailBody
+	}
+	if patch.MQTTPayload != nil {
+		t.MQTTPayload = *patch.MQTTPayload
+	}
+}
+
+func NewAddNotificationTemplateRequest(dto dtos.NotificationTemplate) AddNotificationTemplateRequest {
+	return AddNotificationTemplateRequest{
+		BaseRequest:          dtoCommon.NewBaseRequest(),
+		NotificationTemplate: dto,
+	}
+}
+
+func NewUpdateNotificationTemplateRequest(dto dtos.UpdateNotificationTemplate) UpdateNotificationTemplateRequest {
+	return UpdateNotificationTemplateRequest{
+		BaseRequest:          dtoCommon.NewBaseRequest(),
+		NotificationTemplate: dto,
+	}
+}
@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+const (
+	testNotificationTemplateName    = "device-alert"
+	testNotificationTemplateContent = "[{{ .Severity }}] {{ .Content }}"
+)
+
+func addNotificationTemplateRequestData() AddNotificationTemplateRequest {
+	return NewAddNotificationTemplateRequest(dtos.NotificationTemplate{
+		Name:         testNotificationTemplateName,
+		Description:  "alert of the devices",
+		Content:      testNotificationTemplateContent,
+		EmailSubject: "{{ .Severity }} {{ .Category }}",
+		MQTTPayload:  `{"content":{{ json .Content }}}`,
+	})
+}
+
+func TestAddNotificationTemplateRequest_Validate(t *testing.T) {
+	valid := addNotificationTemplateRequestData()
+	noName := addNotificationTemplateRequestData()
+	noName.NotificationTemplate.Name = ""
+	blankName := addNotificationTemplateRequestData()
+	blankName.NotificationTemplate.Name = " "
+	invalidId := addNotificationTemplateRequestData()
+	invalidId.NotificationTemplate.Id = "abc"
+	syntaxError := addNotificationTemplateRequestData()
+	syntaxError.NotificationTemplate.EmailBody = "{{ .Content "
+	unknownField := addNotificationTemplateRequestData()
+	unknownField.NotificationTemplate.Content = "{{ .Message }}"
+
+	tests := []struct {
+		name        string
+		request     AddNotificationTemplateRequest
+		expectError bool
+	}{
+		{"valid", valid, false},
+		{"invalid, no name", noName, true},
+		{"invalid, blank name", blankName, true},
+		{"invalid, ID is not an UUID", invalidId, true},
+		{"invalid, syntax error", syntaxError, true},
+		{"invalid, unknown field", unknownField, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected AddNotificationTemplateRequest validation result.", err)
+		})
+	}
+}
+
+func TestAddNotificationTemplateRequest_UnmarshalJSON(t *testing.T) {
+	valid := addNotificationTemplateRequestData()
+	jsonData, err := json.Marshal(valid)
+	require.NoError(t, err)
+
+	var result AddNotificationTemplateRequest
+	require.NoError(t, json.Unmarshal(jsonData, &result))
+	assert.Equal(t, valid, result)
+
+	require.Error(t, json.Unmarshal([]byte(`{"apiVersion":"v3","notificationTemplate":{"content":"{{ .Content }}"}}`), &result))
+	require.Error(t, json.Unmarshal([]byte("Invalid AddNotificationTemplateRequest"), &result))
+}
+
+func TestAddNotificationTemplateReqToNotificationTemplateModels(t *testing.T) {
+	request := addNotificationTemplateRequestData()
+	expected := []models.NotificationTemplate{dtos.ToNotificationTemplateModel(request.NotificationTemplate)}
+	assert.Equal(t, expected, AddNotificationTemplateReqToNotificationTemplateModels([]AddNotificationTemplateRequest{request}))
+}
+
+func TestUpdateNotificationTemplateRequest_Validate(t *testing.T) {
+	id := ExampleUUID
+	name := testNotificationTemplateName
+	invalidUUID := "abc"
+	blank := " "
+	empty := ""
+	content := testNotificationTemplateContent
+	syntaxError := "{{ .Content "
+
+	tests := []struct {
+		name        string
+		template    dtos.UpdateNotificationTemplate
+		expectError bool
+	}{
+		{"valid, only ID", dtos.UpdateNotificationTemplate{Id: &id, Content: &content}, false},
+		{"valid, only name", dtos.UpdateNotificationTemplate{Name: &name, Content: &content}, false},
+		{"valid, empty variant to remove the variant", dtos.UpdateNotificationTemplate{Name: &name, EmailBody: &empty}, false},
+		{"invalid, no ID and name", dtos.UpdateNotificationTemplate{Content: &content}, true},
+		{"invalid, ID is not an UUID", dtos.UpdateNotificationTemplate{Id: &invalidUUID}, true},
+		{"invalid, blank name", dtos.UpdateNotificationTemplate{Name: &blank}, true},
+		{"invalid, content syntax error", dtos.UpdateNotificationTemplate{Name: &name, Content: &syntaxError}, true},
+		{"invalid, MQTT payload syntax error", dtos.UpdateNotificationTemplate{Name: &name, MQTTPayload: &syntaxError}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewUpdateNotificationTemplateRequest(tt.template).Validate()
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected UpdateNotificationTemplateRequest validation result.", err)
+		})
+	}
+}
+
+func TestUpdateNotificationTemplateRequest_UnmarshalJSON(t *testing.T) {
+	name := testNotificationTemplateName
+	content := testNotificationTemplateContent
+	valid := NewUpdateNotificationTemplateRequest(dtos.UpdateNotificationTemplate{Name: &name, Content: &content})
+	jsonData, err := json.Marshal(valid)
+	require.NoError(t, err)
+
+	var result UpdateNotificationTemplateRequest
+	require.NoError(t, json.Unmarshal(jsonData, &result))
+	assert.Equal(t, valid, result)
+
+	require.Error(t, json.Unmarshal([]byte(`{"apiVersion":"v3","notificationTemplate":{"content":"{{ .Content }}"}}`), &result))
+	require.Error(t, json.Unmarshal([]byte("Invalid UpdateNotificationTemplateRequest"), &result))
+}
+
+func TestReplaceNotificationTemplateModelFieldsWithDTO(t *testing.T) {
+	template := models.NotificationTemplate{
+		Id:        ExampleUUID,
+		Name:      testNotificationTemplateName,
+		Content:   "{{ .Content }}",
+		EmailBody: "{{ .Content }}",
+	}
+	description := "updated"
+	content := testNotificationTemplateContent
+	emailSubject := "{{ .Severity }}"
+	empty := ""
+	mqttPayload := `{"content":{{ json .Content }}}`
+
+	ReplaceNotificationTemplateModelFieldsWithDTO(&template, dtos.UpdateNotificationTemplate{
+		Description:  &description,
+		Content:      &content,
+		EmailSubject: &emailSubject,
+		EmailBody:    &empty,
+		MQTTPayload:  &mqttPayload,
+	})
+
+	assert.Equal(t, models.NotificationTemplate{
+		Id:           ExampleUUID,
+		Name:         testNotificationTemplateName,
+		Description:  description,
+		Content:      content,
+		EmailSubject: emailSubject,
+		MQTTPayload:  mqttPayload,
+	}, template)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -21,7 +21,8 @@ type AddScheduleJobRequest struct {
 	ScheduleJob           dtos.ScheduleJob `json:"scheduleJob"`
 }
 
-// Validate satisfies the Validator interface
+// Validate satisfies the Validator interface. The dependency cycle across the jobs is not checked, and the caller
+// should check it by models.ValidateScheduleJobDependency before adding the job.
 func (a *AddScheduleJobRequest) Validate() error {
 	err := common.Validate(a)
 	if err != nil {
@@ -32,7 +33,7 @@ func (a *AddScheduleJobRequest) Validate() error {
 	if err != nil {
 		return err
 	}
-	return nil
+	return validateScheduleActionPayloads(a.ScheduleJob.Actions)
 }
 
 // UnmarshalJSON implements the Unmarshaler interface for the AddScheduleJobRequest type
@@ -64,7 +65,8 @@ type UpdateScheduleJobRequest struct {
 	ScheduleJob           dtos.UpdateScheduleJob `json:"scheduleJob"`
 }
 
-// Validate satisfies the Validator interface
+// Validate satisfies the Validator interface. The dependency cycle across the jobs is not checked, and the caller
+// should check the updated job by models.ValidateScheduleJobDependency before saving it.
 func (u *UpdateScheduleJobRequest) Validate() error {
 	err := common.Validate(u)
 	if err != nil {
@@ -78,6 +80,16 @@ func (u *UpdateScheduleJobRequest) Validate() error {
 		}
 	}
 
+	if dependency := u.ScheduleJob.Dependency; dependency != nil && !dependency.IsEmpty() {
+		err = common.Validate(dependency)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleJobDependency.", err)
+		}
+		if u.ScheduleJob.Name != nil && dependency.JobName == *u.ScheduleJob.Name {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleJobDependency, the job can't depend on itself.", nil)
+		}
+	}
+
 	if u.ScheduleJob.Actions != nil {
 		for _, action := range u.ScheduleJob.Actions {
 			err = action.Validate()
@@ -87,6 +99,20 @@ func (u *UpdateScheduleJobRequest) Validate() error {
 		}
 	}
 
+	return validateScheduleActionPayloads(u.ScheduleJob.Actions)
+}
+
+// validateScheduleActionPayloads validates the payloads of the KVS actions against UpdateKeysRequest
+func validateScheduleActionPayloads(actions []dtos.ScheduleAction) error {
+	for _, action := range actions {
+		if action.Type != common.ActionKVS {
+			continue
+		}
+		var req UpdateKeysRequest
+		if err := json.Unmarshal(action.Payload, &req); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KVSAction, the payload should be an UpdateKeysRequest.", err)
+		}
+	}
 	return nil
 }
 
@@ -109,7 +135,8 @@ func (u *UpdateScheduleJobRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceScheduleJobModelFieldsWithDTO replace existing ScheduleJob's fields with DTO patch
+// ReplaceScheduleJobModelFieldsWithDTO replace existing ScheduleJob's fields with DTO patch, and an empty Dependency
+// removes the dependency of the job
 func ReplaceScheduleJobModelFieldsWithDTO(ds *models.ScheduleJob, patch dtos.UpdateScheduleJob) {
 	if patch.Actions != nil {
 		ds.Actions = dtos.ToScheduleActionModels(patch.Actions)
@@ -126,6 +153,13 @@ func ReplaceScheduleJobModelFieldsWithDTO(ds *models.ScheduleJob, patch dtos.Upd
 	if patch.Definition != nil {
 		ds.Definition = dtos.ToScheduleDefModel(*patch.Definition)
 	}
+	if patch.Dependency != nil {
+		if patch.Dependency.IsEmpty() {
+			ds.Dependency = nil
+		} else {
+			ds.Dependency = dtos.ToScheduleJobDependencyModel(patch.Dependency)
+		}
+	}
 	if patch.Properties != nil {
 		ds.Properties = patch.Properties
 	}
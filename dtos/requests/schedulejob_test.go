@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -49,6 +49,15 @@ var (
 	testAutoTriggerMissedRecords = true
 )
 
+func testKVSAction(payload string) dtos.ScheduleAction {
+	return dtos.ScheduleAction{
+		Type:        common.ActionKVS,
+		ContentType: common.ContentTypeJSON,
+		Payload:     []byte(payload),
+		KVSAction:   dtos.KVSAction{Key: "edgex/v4/core-data"},
+	}
+}
+
 func addScheduleJobRequestData() AddScheduleJobRequest {
 	return NewAddScheduleJobRequest(dtos.ScheduleJob{
 		Name:       testScheduleJobName,
@@ -104,12 +113,19 @@ func TestAddScheduleJobRequest_Validate(t *testing.T) {
 		{Type: "unknown"},
 	}
 
+	validKVSAction := addScheduleJobRequestData()
+	validKVSAction.ScheduleJob.Actions = []dtos.ScheduleAction{testKVSAction(`{"value":{"Writable":{"LogLevel":"DEBUG"}}}`)}
+	invalidKVSActionPayload := addScheduleJobRequestData()
+	invalidKVSActionPayload.ScheduleJob.Actions = []dtos.ScheduleAction{testKVSAction(`{"value":{}}`)}
+
 	tests := []struct {
 		name        string
 		ScheduleJob AddScheduleJobRequest
 		expectError bool
 	}{
 		{"valid", valid, false},
+		{"valid, KVS action", validKVSAction, false},
+		{"invalid, KVS action payload is not an UpdateKeysRequest", invalidKVSActionPayload, true},
 		{"valid, no request ID", noReqId, false},
 		{"invalid, request ID is not an UUID", invalidReqId, true},
 		{"invalid, no schedule job name", noScheduleJobName, true},
@@ -216,6 +232,20 @@ func TestUpdateScheduleJobRequest_Validate(t *testing.T) {
 		},
 	}
 
+	invalidKVSActionPayload := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	invalidKVSActionPayload.ScheduleJob.Actions = []dtos.ScheduleAction{testKVSAction(`{"key":"value"}`)}
+
+	validDependency := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	validDependency.ScheduleJob.Dependency = &dtos.ScheduleJobDependency{JobName: "upstream-job", Condition: common.DependencyOnSucceeded}
+	invalidDependencyCondition := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	invalidDependencyCondition.ScheduleJob.Dependency = &dtos.ScheduleJobDependency{JobName: "upstream-job", Condition: "invalid"}
+	selfDependency := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	selfDependency.ScheduleJob.Dependency = &dtos.ScheduleJobDependency{JobName: *selfDependency.ScheduleJob.Name, Condition: common.DependencyOnCompleted}
+	removeDependency := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	removeDependency.ScheduleJob.Dependency = &dtos.ScheduleJobDependency{}
+	emptyDependencyJobName := NewUpdateScheduleJobRequest(updateScheduleJobData())
+	emptyDependencyJobName.ScheduleJob.Dependency = &dtos.ScheduleJobDependency{Condition: common.DependencyOnCompleted}
+
 	tests := []struct {
 		name        string
 		req         UpdateScheduleJobRequest
@@ -238,6 +268,12 @@ func TestUpdateScheduleJobRequest_Validate(t *testing.T) {
 		{"valid, empty actions", emptyActions, false},
 		{"valid, empty labels", emptyLabels, false},
 		{"invalid, invalid action type", invalidActions, true},
+		{"invalid, KVS action payload is not an UpdateKeysRequest", invalidKVSActionPayload, true},
+		{"valid, with dependency", validDependency, false},
+		{"invalid, invalid dependency condition", invalidDependencyCondition, true},
+		{"invalid, depends on itself", selfDependency, true},
+		{"valid, empty dependency to remove the dependency", removeDependency, false},
+		{"invalid, empty dependency job name", emptyDependencyJobName, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -280,6 +316,7 @@ func TestReplaceScheduleJobModelFieldsWithDTO(t *testing.T) {
 		Name: testScheduleJobName,
 	}
 	patch := updateScheduleJobData()
+	patch.Dependency = &dtos.ScheduleJobDependency{JobName: "upstream-job", Condition: common.DependencyOnSucceeded}
 
 	ReplaceScheduleJobModelFieldsWithDTO(&job, patch)
 
@@ -289,4 +326,9 @@ func TestReplaceScheduleJobModelFieldsWithDTO(t *testing.T) {
 	assert.Equal(t, expectedActions, job.Actions)
 	assert.Equal(t, testAutoTriggerMissedRecords, job.AutoTriggerMissedRecords)
 	assert.Equal(t, expectedDef, job.Definition)
+	assert.Equal(t, dtos.ToScheduleJobDependencyModel(patch.Dependency), job.Dependency)
+
+	ReplaceScheduleJobModelFieldsWithDTO(&job, dtos.UpdateScheduleJob{Dependency: &dtos.ScheduleJobDependency{}})
+	assert.Nil(t, job.Dependency, "the empty dependency should remove the dependency")
+	assert.Equal(t, expectedDef, job.Definition)
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -16,7 +16,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-var supportedChannelTypes = []string{common.EMAIL, common.REST, common.MQTT, common.ZeroMQ}
+var supportedChannelTypes = []string{common.EMAIL, common.REST, common.MQTT, common.ZeroMQ, common.WEBHOOK, common.KAFKA, common.NATS}
 
 // AddSubscriptionRequest defines the Request Content for POST Subscription DTO.
 type AddSubscriptionRequest struct {
@@ -38,6 +38,20 @@ func (request AddSubscriptionRequest) Validate() error {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not valid type for Channel", c.Type), nil)
 		}
 	}
+	if request.Subscription.Template != nil {
+		if request.Subscription.TemplateName != "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the templateName and the inline template must not be both specified", nil)
+		}
+		if err = request.Subscription.Template.Validate(); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if err = validateQuietHours(request.Subscription.TimeZone, request.Subscription.QuietHours); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err = validateEscalationPolicy(request.Subscription.Name, request.Subscription.EscalationPolicy); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	return nil
 }
 
@@ -89,6 +103,35 @@ func (request UpdateSubscriptionRequest) Validate() error {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not valid type for Channel", c.Type), nil)
 		}
 	}
+	if template := request.Subscription.Template; template != nil && !template.IsEmpty() {
+		if templateName := request.Subscription.TemplateName; templateName != nil && *templateName != "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the templateName and the inline template must not be both specified", nil)
+		}
+		if err = template.Validate(); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	var timeZone string
+	if request.Subscription.TimeZone != nil {
+		timeZone = *request.Subscription.TimeZone
+	}
+	if err = validateQuietHours(timeZone, request.Subscription.QuietHours); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var name string
+	if request.Subscription.Name != nil {
+		name = *request.Subscription.Name
+	}
+	if policy := request.Subscription.EscalationPolicy; policy != nil && !policy.IsEmpty() {
+		if err = validateEscalationPolicy(name, policy); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if digest := request.Subscription.Digest; digest != nil && !digest.IsEmpty() {
+		if err = common.Validate(digest); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid Digest.", err)
+		}
+	}
 	if request.Subscription.Categories != nil && request.Subscription.Labels != nil &&
 		len(request.Subscription.Categories) == 0 && len(request.Subscription.Labels) == 0 {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "categories and labels can not be both empty", nil)
@@ -115,7 +158,8 @@ func (request *UpdateSubscriptionRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceSubscriptionModelFieldsWithDTO replace existing Subscription's fields with DTO patch
+// ReplaceSubscriptionModelFieldsWithDTO replace existing Subscription's fields with DTO patch. An empty TemplateName
+// removes the template reference, and an empty Template, EscalationPolicy or Digest removes that of the subscription.
 func ReplaceSubscriptionModelFieldsWithDTO(s *models.Subscription, patch dtos.UpdateSubscription) {
 	if patch.Channels != nil {
 		s.Channels = dtos.ToAddressModels(patch.Channels)
@@ -141,6 +185,42 @@ func ReplaceSubscriptionModelFieldsWithDTO(s *models.Subscription, patch dtos.Up
 	if patch.AdminState != nil {
 		s.AdminState = models.AdminState(*patch.AdminState)
 	}
+	if patch.GroupingKeys != nil {
+		s.GroupingKeys = patch.GroupingKeys
+	}
+	if patch.TemplateName != nil {
+		s.TemplateName = *patch.TemplateName
+	}
+	if patch.Template != nil {
+		s.Template = nil
+		if !patch.Template.IsEmpty() {
+			template := dtos.ToNotificationTemplateModel(*patch.Template)
+			s.Template = &template
+		}
+	}
+	if patch.EscalationPolicy != nil {
+		s.EscalationPolicy = nil
+		if !patch.EscalationPolicy.IsEmpty() {
+			policy := dtos.ToEscalationPolicyModel(*patch.EscalationPolicy)
+			s.EscalationPolicy = &policy
+		}
+	}
+	if patch.Digest != nil {
+		s.Digest = nil
+		if !patch.Digest.IsEmpty() {
+			digest := models.Digest(*patch.Digest)
+			s.Digest = &digest
+		}
+	}
+	if patch.TimeZone != nil {
+		s.TimeZone = *patch.TimeZone
+	}
+	if patch.QuietHours != nil {
+		s.QuietHours = dtos.ToDailyTimeWindowModels(patch.QuietHours)
+	}
+	if patch.QuietHoursOverrideSeverity != nil {
+		s.QuietHoursOverrideSeverity = models.NotificationSeverity(*patch.QuietHoursOverrideSeverity)
+	}
 }
 
 func NewAddSubscriptionRequest(dto dtos.Subscription) AddSubscriptionRequest {
@@ -157,6 +237,44 @@ func NewUpdateSubscriptionRequest(dto dtos.UpdateSubscription) UpdateSubscriptio
 	}
 }
 
+// validateQuietHours validates the quiet hours and the time zone in which they are evaluated
+func validateQuietHours(timeZone string, quietHours []dtos.DailyTimeWindow) error {
+	if timeZone != "" {
+		if _, err := common.LoadTimeZone(timeZone); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	for _, w := range quietHours {
+		if err := w.Validate(); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// validateEscalationPolicy validates the escalation policy of the subscription, which should not escalate to the
+// subscription itself or to an unsupported channel type
+func validateEscalationPolicy(subscriptionName string, policy *dtos.EscalationPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for i, level := range policy.Levels {
+		if subscriptionName != "" && level.SubscriptionName == subscriptionName {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("escalation level %d should not escalate to the subscription itself", i), nil)
+		}
+		for _, c := range level.Channels {
+			if !contains(supportedChannelTypes, c.Type) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not valid type for Channel", c.Type), nil)
+			}
+		}
+	}
+	return nil
+}
+
 func contains(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
@@ -95,6 +96,15 @@ func TestAddSubscriptionRequest_Validate(t *testing.T) {
 	unsupportedChannelType.Subscription.Channels = []dtos.Address{
 		{Type: "unknown"},
 	}
+	validWebhookChannel := addSubscriptionRequestData()
+	validWebhookChannel.Subscription.Channels = []dtos.Address{
+		dtos.NewWebhookAddress("https", "hooks.example.com", 443, "/services/edgex", http.MethodPost, `{"text": {{ json .Content }}}`, "webhook"),
+	}
+	validBrokerChannels := addSubscriptionRequestData()
+	validBrokerChannels.Subscription.Channels = []dtos.Address{
+		dtos.NewKafkaAddress([]string{"localhost:9092"}, "edgex-notifications"),
+		dtos.NewNATSAddress([]string{"nats://localhost:4222"}, "edgex.notifications"),
+	}
 
 	noCategories := addSubscriptionRequestData()
 	noCategories.Subscription.Categories = nil
@@ -114,6 +124,75 @@ func TestAddSubscriptionRequest_Validate(t *testing.T) {
 	invalidResendInterval := addSubscriptionRequestData()
 	invalidResendInterval.Subscription.ResendInterval = "10"
 
+	validTemplate := addSubscriptionRequestData()
+	validTemplate.Subscription.Template = &dtos.NotificationTemplate{
+		Name:         "alert",
+		EmailSubject: "[{{ .Severity }}] {{ .Category }}",
+		MQTTPayload:  `{"content":{{ json .Content }}}`,
+	}
+	templateSyntaxError := addSubscriptionRequestData()
+	templateSyntaxError.Subscription.Template = &dtos.NotificationTemplate{Name: "alert", EmailBody: "{{ .Content "}
+	templateWithoutName := addSubscriptionRequestData()
+	templateWithoutName.Subscription.Template = &dtos.NotificationTemplate{Content: "{{ .Content }}"}
+	validTemplateName := addSubscriptionRequestData()
+	validTemplateName.Subscription.TemplateName = "device-alert"
+	blankTemplateName := addSubscriptionRequestData()
+	blankTemplateName.Subscription.TemplateName = emptyString
+	templateNameAndTemplate := addSubscriptionRequestData()
+	templateNameAndTemplate.Subscription.TemplateName = "device-alert"
+	templateNameAndTemplate.Subscription.Template = &dtos.NotificationTemplate{Content: "{{ .Content }}"}
+
+	validGroupingKeys := addSubscriptionRequestData()
+	validGroupingKeys.Subscription.GroupingKeys = []string{common.GroupingKeyCategory, common.GroupingKeyLabels, common.GroupingKeyDedupKey}
+	unknownGroupingKey := addSubscriptionRequestData()
+	unknownGroupingKey.Subscription.GroupingKeys = []string{"description"}
+	duplicateGroupingKeys := addSubscriptionRequestData()
+	duplicateGroupingKeys.Subscription.GroupingKeys = []string{common.GroupingKeySender, common.GroupingKeySender}
+
+	validQuietHours := addSubscriptionRequestData()
+	validQuietHours.Subscription.TimeZone = "America/New_York"
+	validQuietHours.Subscription.QuietHours = []dtos.DailyTimeWindow{{StartHour: 22, EndHour: 7}, {StartHour: 12, EndHour: 13, EndMinute: 30}}
+	validQuietHours.Subscription.QuietHoursOverrideSeverity = models.Critical
+	validQuietHours.Subscription.Channels = []dtos.Address{dtos.NewEmailAddress([]string{"test@example.com"})}
+	validQuietHours.Subscription.Channels[0].MinSeverity = models.Normal
+	invalidTimeZone := addSubscriptionRequestData()
+	invalidTimeZone.Subscription.TimeZone = "Local"
+	invalidQuietHourRange := addSubscriptionRequestData()
+	invalidQuietHourRange.Subscription.QuietHours = []dtos.DailyTimeWindow{{StartHour: 22, EndHour: 24}}
+	emptyQuietHours := addSubscriptionRequestData()
+	emptyQuietHours.Subscription.QuietHours = []dtos.DailyTimeWindow{{StartHour: 8, StartMinute: 15, EndHour: 8, EndMinute: 15}}
+	invalidOverrideSeverity := addSubscriptionRequestData()
+	invalidOverrideSeverity.Subscription.QuietHoursOverrideSeverity = "HIGH"
+	invalidChannelMinSeverity := addSubscriptionRequestData()
+	invalidChannelMinSeverity.Subscription.Channels = []dtos.Address{dtos.NewEmailAddress([]string{"test@example.com"})}
+	invalidChannelMinSeverity.Subscription.Channels[0].MinSeverity = "HIGH"
+
+	validEscalation := addSubscriptionRequestData()
+	validEscalation.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{AfterFailures: 3, SubscriptionName: "on-call"},
+		{AckTimeout: "1h", Channels: []dtos.Address{dtos.NewEmailAddress([]string{"manager@example.com"})}},
+	}}
+	escalationToItself := addSubscriptionRequestData()
+	escalationToItself.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{AfterFailures: 3, SubscriptionName: testSubscriptionName},
+	}}
+	escalationWithoutTrigger := addSubscriptionRequestData()
+	escalationWithoutTrigger.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{SubscriptionName: "on-call"},
+	}}
+	escalationUnsupportedChannel := addSubscriptionRequestData()
+	escalationUnsupportedChannel.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{AckTimeout: "1h", Channels: []dtos.Address{{Type: "unknown"}}},
+	}}
+	validDigest := addSubscriptionRequestData()
+	validDigest.Subscription.Digest = &dtos.Digest{Interval: "1h", MaxItems: 50}
+	digestWithoutInterval := addSubscriptionRequestData()
+	digestWithoutInterval.Subscription.Digest = &dtos.Digest{MaxItems: 50}
+	digestIntervalTooShort := addSubscriptionRequestData()
+	digestIntervalTooShort.Subscription.Digest = &dtos.Digest{Interval: "30s"}
+	digestNegativeMaxItems := addSubscriptionRequestData()
+	digestNegativeMaxItems.Subscription.Digest = &dtos.Digest{Interval: "1h", MaxItems: -1}
+
 	tests := []struct {
 		name         string
 		Subscription AddSubscriptionRequest
@@ -129,11 +208,36 @@ func TestAddSubscriptionRequest_Validate(t *testing.T) {
 		{"invalid, no channels specified", noChannel, true},
 		{"invalid, email address is invalid", invalidEmailAddress, true},
 		{"invalid, unsupported channel type", unsupportedChannelType, true},
+		{"valid, WEBHOOK channel", validWebhookChannel, false},
+		{"valid, KAFKA and NATS channels", validBrokerChannels, false},
 		{"invalid, no categories and labels specified", noCategoriesAndLabels, true},
 		{"invalid, unsupported category type", categoryNameWithReservedChar, true},
 		{"invalid, no receiver specified", noReceiver, true},
 		{"invalid, receiver name containing reserved chars", receiverNameWithReservedChars, true},
 		{"invalid, resendInterval is not specified in ISO8601 format", invalidResendInterval, true},
+		{"valid, with template", validTemplate, false},
+		{"invalid, template syntax error", templateSyntaxError, true},
+		{"valid, template without name", templateWithoutName, false},
+		{"valid, with template name", validTemplateName, false},
+		{"invalid, blank template name", blankTemplateName, true},
+		{"invalid, both template name and template", templateNameAndTemplate, true},
+		{"valid, with grouping keys", validGroupingKeys, false},
+		{"invalid, unknown grouping key", unknownGroupingKey, true},
+		{"invalid, duplicate grouping keys", duplicateGroupingKeys, true},
+		{"valid, with quiet hours and channel minimum severity", validQuietHours, false},
+		{"invalid, unknown time zone", invalidTimeZone, true},
+		{"invalid, quiet hour out of range", invalidQuietHourRange, true},
+		{"invalid, quiet hours start equals end", emptyQuietHours, true},
+		{"invalid, unknown quiet hours override severity", invalidOverrideSeverity, true},
+		{"invalid, unknown channel minimum severity", invalidChannelMinSeverity, true},
+		{"valid, with escalation policy", validEscalation, false},
+		{"invalid, escalation to the subscription itself", escalationToItself, true},
+		{"invalid, escalation level without trigger", escalationWithoutTrigger, true},
+		{"invalid, escalation to unsupported channel type", escalationUnsupportedChannel, true},
+		{"valid, with digest", validDigest, false},
+		{"invalid, digest without interval", digestWithoutInterval, true},
+		{"invalid, digest interval shorter than a minute", digestIntervalTooShort, true},
+		{"invalid, digest with negative max items", digestNegativeMaxItems, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -251,6 +355,55 @@ func TestUpdateSubscriptionRequest_Validate(t *testing.T) {
 	emptyCategoriesAndLabels.Subscription.Categories = []string{}
 	emptyCategoriesAndLabels.Subscription.Labels = []string{}
 
+	validTemplate := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validTemplate.Subscription.Template = &dtos.NotificationTemplate{Name: "alert", Content: "{{ .Content }}"}
+
+	timeZone := "Europe/Paris"
+	validQuietHours := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validQuietHours.Subscription.TimeZone = &timeZone
+	validQuietHours.Subscription.QuietHours = []dtos.DailyTimeWindow{{StartHour: 23, StartMinute: 30, EndHour: 6}}
+	invalidTimeZoneValue := "Paris"
+	invalidTimeZone := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	invalidTimeZone.Subscription.TimeZone = &invalidTimeZoneValue
+	invalidQuietHours := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	invalidQuietHours.Subscription.QuietHours = []dtos.DailyTimeWindow{{StartHour: 23, StartMinute: 60, EndHour: 6}}
+	validEscalation := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validEscalation.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{AckTimeout: "15m", SubscriptionName: "on-call"},
+	}}
+	escalationToItself := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	escalationToItself.Subscription.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{
+		{AckTimeout: "15m", SubscriptionName: testSubscriptionName},
+	}}
+	emptyEscalation := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	emptyEscalation.Subscription.EscalationPolicy = &dtos.EscalationPolicy{}
+	templateUnknownField := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	templateUnknownField.Subscription.Template = &dtos.NotificationTemplate{Name: "alert", Content: "{{ .Message }}"}
+	validDigest := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validDigest.Subscription.Digest = &dtos.Digest{Interval: "1d"}
+	invalidDigestInterval := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	invalidDigestInterval.Subscription.Digest = &dtos.Digest{Interval: "hourly"}
+	digestWithoutInterval := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	digestWithoutInterval.Subscription.Digest = &dtos.Digest{MaxItems: 50}
+	emptyDigest := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	emptyDigest.Subscription.Digest = &dtos.Digest{}
+	emptyTemplate := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	emptyTemplate.Subscription.Template = &dtos.NotificationTemplate{}
+	templateName := "device-alert"
+	validTemplateName := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validTemplateName.Subscription.TemplateName = &templateName
+	noTemplateName := ""
+	emptyTemplateName := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	emptyTemplateName.Subscription.TemplateName = &noTemplateName
+	blankTemplateName := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	blankTemplateName.Subscription.TemplateName = &emptyString
+	templateNameAndTemplate := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	templateNameAndTemplate.Subscription.TemplateName = &templateName
+	templateNameAndTemplate.Subscription.Template = &dtos.NotificationTemplate{Content: "{{ .Content }}"}
+	templateNameAndEmptyTemplate := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	templateNameAndEmptyTemplate.Subscription.TemplateName = &templateName
+	templateNameAndEmptyTemplate.Subscription.Template = &dtos.NotificationTemplate{}
+
 	tests := []struct {
 		name        string
 		req         UpdateSubscriptionRequest
@@ -277,6 +430,24 @@ func TestUpdateSubscriptionRequest_Validate(t *testing.T) {
 		{"valid, empty categories", emptyCategories, false},
 		{"valid, empty labels", emptyLabels, false},
 		{"invalid, empty categories and labels", emptyCategoriesAndLabels, true},
+		{"valid, with template", validTemplate, false},
+		{"invalid, template refers to unknown field", templateUnknownField, true},
+		{"valid, with quiet hours", validQuietHours, false},
+		{"invalid, unknown time zone", invalidTimeZone, true},
+		{"invalid, quiet hour minute out of range", invalidQuietHours, true},
+		{"valid, with escalation policy", validEscalation, false},
+		{"invalid, escalation to the subscription itself", escalationToItself, true},
+		{"valid, empty escalation policy to remove the policy", emptyEscalation, false},
+		{"valid, with digest", validDigest, false},
+		{"invalid, digest interval", invalidDigestInterval, true},
+		{"invalid, digest without interval", digestWithoutInterval, true},
+		{"valid, empty digest to remove the digest", emptyDigest, false},
+		{"valid, empty template to remove the template", emptyTemplate, false},
+		{"valid, with template name", validTemplateName, false},
+		{"valid, empty template name to remove the template reference", emptyTemplateName, false},
+		{"invalid, blank template name", blankTemplateName, true},
+		{"invalid, both template name and template", templateNameAndTemplate, true},
+		{"valid, template name and empty template to replace the template", templateNameAndEmptyTemplate, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,6 +490,17 @@ func TestReplaceSubscriptionModelFieldsWithDTO(t *testing.T) {
 		Name: "name",
 	}
 	patch := updateSubscriptionData()
+	templateName := "device-alert"
+	patch.TemplateName = &templateName
+	patch.Template = &dtos.NotificationTemplate{Name: "alert", Content: "{{ .Content }}"}
+	patch.GroupingKeys = []string{common.GroupingKeySeverity}
+	timeZone := "Asia/Taipei"
+	overrideSeverity := models.Critical
+	patch.TimeZone = &timeZone
+	patch.QuietHours = []dtos.DailyTimeWindow{{StartHour: 22, EndHour: 7}}
+	patch.QuietHoursOverrideSeverity = &overrideSeverity
+	patch.EscalationPolicy = &dtos.EscalationPolicy{Levels: []dtos.EscalationLevel{{AckTimeout: "15m", SubscriptionName: "on-call"}}}
+	patch.Digest = &dtos.Digest{Interval: "1h", MaxItems: 50}
 
 	ReplaceSubscriptionModelFieldsWithDTO(&subscription, patch)
 
@@ -329,4 +511,45 @@ func TestReplaceSubscriptionModelFieldsWithDTO(t *testing.T) {
 	assert.Equal(t, testSubscriptionReceiver, subscription.Receiver)
 	assert.Equal(t, testSubscriptionResendLimit, subscription.ResendLimit)
 	assert.Equal(t, testSubscriptionResendInterval, subscription.ResendInterval)
+	assert.Equal(t, templateName, subscription.TemplateName)
+	assert.Equal(t, &models.NotificationTemplate{Name: "alert", Content: "{{ .Content }}"}, subscription.Template)
+	assert.Equal(t, []string{common.GroupingKeySeverity}, subscription.GroupingKeys)
+	assert.Equal(t, timeZone, subscription.TimeZone)
+	assert.Equal(t, []models.DailyTimeWindow{{StartHour: 22, EndHour: 7}}, subscription.QuietHours)
+	assert.Equal(t, models.NotificationSeverity(models.Critical), subscription.QuietHoursOverrideSeverity)
+	assert.Equal(t, &models.EscalationPolicy{Levels: []models.EscalationLevel{{AckTimeout: "15m", SubscriptionName: "on-call"}}},
+		subscription.EscalationPolicy)
+	assert.Equal(t, &models.Digest{Interval: "1h", MaxItems: 50}, subscription.Digest)
+
+	noTemplateName := ""
+	ReplaceSubscriptionModelFieldsWithDTO(&subscription, dtos.UpdateSubscription{
+		TemplateName:     &noTemplateName,
+		Template:         &dtos.NotificationTemplate{},
+		EscalationPolicy: &dtos.EscalationPolicy{},
+		Digest:           &dtos.Digest{},
+	})
+	assert.Empty(t, subscription.TemplateName, "the empty template name should remove the template reference")
+	assert.Nil(t, subscription.Template, "the empty template should remove the template")
+	assert.Nil(t, subscription.EscalationPolicy, "the empty escalation policy should remove the policy")
+	assert.Nil(t, subscription.Digest, "the empty digest should remove the digest")
+	assert.Equal(t, timeZone, subscription.TimeZone)
+}
+
+func TestUpdateSubscriptionRequest_UnmarshalJSON_RemoveFields(t *testing.T) {
+	data := `{"apiVersion":"v3","subscription":{"name":"name","templateName":"","template":{},"escalationPolicy":{},"digest":{}}}`
+	var req UpdateSubscriptionRequest
+	require.NoError(t, json.Unmarshal([]byte(data), &req))
+
+	subscription := models.Subscription{
+		Name:             "name",
+		TemplateName:     "device-alert",
+		Template:         &models.NotificationTemplate{Content: "{{ .Content }}"},
+		EscalationPolicy: &models.EscalationPolicy{Levels: []models.EscalationLevel{{AckTimeout: "15m", SubscriptionName: "on-call"}}},
+		Digest:           &models.Digest{Interval: "1h"},
+	}
+	ReplaceSubscriptionModelFieldsWithDTO(&subscription, req.Subscription)
+	assert.Empty(t, subscription.TemplateName)
+	assert.Nil(t, subscription.Template)
+	assert.Nil(t, subscription.EscalationPolicy)
+	assert.Nil(t, subscription.Digest)
 }
@@ -1,16 +1,14 @@
 //
-// Copyright (C) 2020 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package responses
 
 import (
-	"encoding/json"
+	"fmt"
 	"os"
 
-	"github.com/fxamacker/cbor/v2"
-
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
@@ -43,25 +41,23 @@ func NewMultiEventsResponse(requestId string, message string, statusCode int, to
 	}
 }
 
+// Encode encodes the EventResponse with the content type determined by GetEncodingContentType
 func (e *EventResponse) Encode() ([]byte, string, error) {
-	var encoding = e.GetEncodingContentType()
-	var err error
-	var encodedData []byte
+	return e.EncodeWithContentType(e.GetEncodingContentType())
+}
 
-	switch encoding {
-	case common.ContentTypeCBOR:
-		encodedData, err = cbor.Marshal(e)
-		if err != nil {
-			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode EventResponse to CBOR", err)
-		}
-	case common.ContentTypeJSON:
-		encodedData, err = json.Marshal(e)
-		if err != nil {
-			return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode EventResponse to JSON", err)
-		}
+// EncodeWithContentType encodes the EventResponse with the Codec registered for the specified content type,
+// which is typically negotiated from the Accept header of the request via common.NegotiateCodecFor
+func (e *EventResponse) EncodeWithContentType(contentType string) ([]byte, string, error) {
+	codec, edgexErr := common.GetCodec(contentType)
+	if edgexErr != nil {
+		return nil, "", errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-
-	return encodedData, encoding, nil
+	encodedData, err := codec.Marshal(e)
+	if err != nil {
+		return nil, "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode EventResponse to %s", codec.ContentType()), err)
+	}
+	return encodedData, codec.ContentType(), nil
 }
 
 // GetEncodingContentType determines which content type should be used to encode and decode this object
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+)
+
+// NotificationTemplateResponse defines the NotificationTemplate Content for GET NotificationTemplate DTOs.
+type NotificationTemplateResponse struct {
+	common.BaseResponse  `json:",inline"`
+	NotificationTemplate dtos.NotificationTemplate `json:"notificationTemplate"`
+}
+
+func NewNotificationTemplateResponse(requestId string, message string, statusCode int,
+	template dtos.NotificationTemplate) NotificationTemplateResponse {
+	return NotificationTemplateResponse{
+		BaseResponse:         common.NewBaseResponse(requestId, message, statusCode),
+		NotificationTemplate: template,
+	}
+}
+
+// MultiNotificationTemplatesResponse defines the NotificationTemplate Content for GET multiple NotificationTemplate DTOs.
+type MultiNotificationTemplatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	NotificationTemplates             []dtos.NotificationTemplate `json:"notificationTemplates"`
+}
+
+func NewMultiNotificationTemplatesResponse(requestId string, message string, statusCode int, totalCount int64, templates []dtos.NotificationTemplate) MultiNotificationTemplatesResponse {
+	return MultiNotificationTemplatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		NotificationTemplates:      templates,
+	}
+}
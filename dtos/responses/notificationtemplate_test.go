@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotificationTemplateResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := 200
+	expectedMessage := "unit test message"
+	expectedTemplate := dtos.NotificationTemplate{Name: "test NotificationTemplate"}
+	actual := NewNotificationTemplateResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedTemplate)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedTemplate, actual.NotificationTemplate)
+}
+
+func TestNewMultiNotificationTemplatesResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := 200
+	expectedMessage := "unit test message"
+	expectedTemplates := []dtos.NotificationTemplate{
+		{Name: "test NotificationTemplate1"},
+		{Name: "test NotificationTemplate2"},
+	}
+	expectedTotalCount := int64(len(expectedTemplates))
+	actual := NewMultiNotificationTemplatesResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedTotalCount, expectedTemplates)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedTotalCount, actual.TotalCount)
+	assert.Equal(t, expectedTemplates, actual.NotificationTemplates)
+}
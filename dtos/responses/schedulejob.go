@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -35,3 +35,29 @@ func NewMultiScheduleJobsResponse(requestId string, message string, statusCode i
 		ScheduleJobs:               scheduleJobs,
 	}
 }
+
+// ScheduleJobStatisticsResponse defines the Response Content for GET ScheduleJobStatistics DTO.
+type ScheduleJobStatisticsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Statistics          dtos.ScheduleJobStatistics `json:"statistics"`
+}
+
+func NewScheduleJobStatisticsResponse(requestId string, message string, statusCode int, statistics dtos.ScheduleJobStatistics) ScheduleJobStatisticsResponse {
+	return ScheduleJobStatisticsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Statistics:   statistics,
+	}
+}
+
+// ScheduleJobDependencyGraphResponse defines the Response Content for GET ScheduleJobDependencyGraph DTO.
+type ScheduleJobDependencyGraphResponse struct {
+	common.BaseResponse `json:",inline"`
+	Graph               dtos.ScheduleJobDependencyGraph `json:"graph"`
+}
+
+func NewScheduleJobDependencyGraphResponse(requestId string, message string, statusCode int, graph dtos.ScheduleJobDependencyGraph) ScheduleJobDependencyGraphResponse {
+	return ScheduleJobDependencyGraphResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Graph:        graph,
+	}
+}
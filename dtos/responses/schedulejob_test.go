@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -44,3 +44,41 @@ func TestNewMultiScheduleJobsResponse(t *testing.T) {
 	assert.Equal(t, expectedTotalCount, actual.TotalCount)
 	assert.Equal(t, expectedScheduleJobs, actual.ScheduleJobs)
 }
+
+func TestNewScheduleJobStatisticsResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := http.StatusOK
+	expectedMessage := "unit test message"
+	expectedStatistics := dtos.ScheduleJobStatistics{
+		JobName:        "testJob",
+		Start:          1,
+		End:            2,
+		SucceededCount: 10,
+		FailedCount:    2,
+		MissedCount:    1,
+		LastSucceeded:  2,
+		AverageLatency: 15,
+	}
+	actual := NewScheduleJobStatisticsResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedStatistics)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedStatistics, actual.Statistics)
+}
+
+func TestNewScheduleJobDependencyGraphResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := http.StatusOK
+	expectedMessage := "unit test message"
+	expectedGraph := dtos.ScheduleJobDependencyGraph{
+		Jobs:  []string{"testJob1", "testJob2"},
+		Edges: []dtos.ScheduleJobDependencyEdge{{JobName: "testJob2", DependsOn: "testJob1", Condition: "SUCCEEDED"}},
+	}
+	actual := NewScheduleJobDependencyGraphResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedGraph)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedGraph, actual.Graph)
+}
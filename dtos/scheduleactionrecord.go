@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -18,6 +18,10 @@ type ScheduleActionRecord struct {
 	Status      string         `json:"status" validate:"required,oneof='SUCCEEDED' 'FAILED' 'MISSED'"`
 	ScheduledAt int64          `json:"scheduledAt,omitempty"`
 	Created     int64          `json:"created,omitempty"`
+	// AttemptCount is the number of attempts made to execute the action, including the retries
+	AttemptCount int `json:"attemptCount,omitempty" validate:"gte=0"`
+	// LastError is the error message of the last failed attempt
+	LastError string `json:"lastError,omitempty"`
 }
 
 // Validate satisfies the Validator interface
@@ -43,6 +47,8 @@ func ToScheduleActionRecordModel(dto ScheduleActionRecord) models.ScheduleAction
 	model.Status = models.ScheduleActionRecordStatus(dto.Status)
 	model.ScheduledAt = dto.ScheduledAt
 	model.Created = dto.Created
+	model.AttemptCount = dto.AttemptCount
+	model.LastError = dto.LastError
 
 	return model
 }
@@ -63,6 +69,8 @@ func FromScheduleActionRecordModelToDTO(model models.ScheduleActionRecord) Sched
 	dto.Status = string(model.Status)
 	dto.ScheduledAt = model.ScheduledAt
 	dto.Created = model.Created
+	dto.AttemptCount = model.AttemptCount
+	dto.LastError = model.LastError
 
 	return dto
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -16,20 +16,24 @@ import (
 
 var (
 	scheduleActionRecord = ScheduleActionRecord{
-		Id:          TestUUID,
-		JobName:     jobName,
-		Action:      scheduleActionEdgeXMessageBus,
-		Status:      models.Missed,
-		ScheduledAt: TestTimestamp,
-		Created:     TestTimestamp,
+		Id:           TestUUID,
+		JobName:      jobName,
+		Action:       scheduleActionEdgeXMessageBus,
+		Status:       models.Missed,
+		ScheduledAt:  TestTimestamp,
+		Created:      TestTimestamp,
+		AttemptCount: 2,
+		LastError:    "connection refused",
 	}
 	scheduleActionRecordModel = models.ScheduleActionRecord{
-		Id:          TestUUID,
-		JobName:     jobName,
-		Action:      scheduleActionEdgeXMessageBusModel,
-		Status:      models.Missed,
-		ScheduledAt: TestTimestamp,
-		Created:     TestTimestamp,
+		Id:           TestUUID,
+		JobName:      jobName,
+		Action:       scheduleActionEdgeXMessageBusModel,
+		Status:       models.Missed,
+		ScheduledAt:  TestTimestamp,
+		Created:      TestTimestamp,
+		AttemptCount: 2,
+		LastError:    "connection refused",
 	}
 )
 
@@ -49,6 +53,8 @@ func TestScheduleActionRecord_Validate(t *testing.T) {
 	}
 	invalidStatus := scheduleActionRecord
 	invalidStatus.Status = "xxx"
+	invalidAttemptCount := scheduleActionRecord
+	invalidAttemptCount.AttemptCount = -1
 
 	tests := []struct {
 		name        string
@@ -61,6 +67,7 @@ func TestScheduleActionRecord_Validate(t *testing.T) {
 		{"invalid ScheduleActionRecord, empty Action", emptyAction, true},
 		{"invalid ScheduleActionRecord, invalid Action", invalidAction, true},
 		{"invalid ScheduleActionRecord, invalid Status", invalidStatus, true},
+		{"invalid ScheduleActionRecord, negative AttemptCount", invalidAttemptCount, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -11,31 +11,38 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/cron"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 type ScheduleJob struct {
-	DBTimestamp              `json:",inline"`
-	Id                       string           `json:"id,omitempty" validate:"omitempty,uuid"`
-	Name                     string           `json:"name" validate:"edgex-dto-none-empty-string"`
-	Definition               ScheduleDef      `json:"definition" validate:"required"`
-	AutoTriggerMissedRecords bool             `json:"autoTriggerMissedRecords,omitempty"`
-	Actions                  []ScheduleAction `json:"actions" validate:"required,gt=0,dive"`
-	AdminState               string           `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
-	Labels                   []string         `json:"labels,omitempty"`
-	Properties               map[string]any   `json:"properties"`
+	DBTimestamp `json:",inline"`
+	Id          string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name        string `json:"name" validate:"edgex-dto-none-empty-string"`
+	// Definition is validated by Validate, and it can be empty if the job is only triggered by its Dependency
+	Definition ScheduleDef `json:"definition,omitzero" validate:"-"`
+	// Dependency optionally triggers the job when another job completes
+	Dependency               *ScheduleJobDependency `json:"dependency,omitempty" validate:"omitempty"`
+	AutoTriggerMissedRecords bool                   `json:"autoTriggerMissedRecords,omitempty"`
+	Actions                  []ScheduleAction       `json:"actions" validate:"required,gt=0,dive"`
+	AdminState               string                 `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Labels                   []string               `json:"labels,omitempty"`
+	Properties               map[string]any         `json:"properties"`
 }
 
 type UpdateScheduleJob struct {
-	Id                       *string          `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
-	Name                     *string          `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
-	Definition               *ScheduleDef     `json:"definition" validate:"omitempty"`
-	AutoTriggerMissedRecords *bool            `json:"autoTriggerMissedRecords,omitempty"`
-	Actions                  []ScheduleAction `json:"actions,omitempty"`
-	AdminState               *string          `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
-	Labels                   []string         `json:"labels,omitempty"`
-	Properties               map[string]any   `json:"properties,omitempty"`
+	Id         *string      `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name       *string      `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Definition *ScheduleDef `json:"definition" validate:"omitempty"`
+	// Dependency replaces the dependency of the job, and an empty dependency removes it. It is validated by
+	// UpdateScheduleJobRequest.Validate.
+	Dependency               *ScheduleJobDependency `json:"dependency,omitempty" validate:"-"`
+	AutoTriggerMissedRecords *bool                  `json:"autoTriggerMissedRecords,omitempty"`
+	Actions                  []ScheduleAction       `json:"actions,omitempty"`
+	AdminState               *string                `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Labels                   []string               `json:"labels,omitempty"`
+	Properties               map[string]any         `json:"properties,omitempty"`
 }
 
 // Validate satisfies the Validator interface
@@ -45,9 +52,16 @@ func (s *ScheduleJob) Validate() error {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleJob.", err)
 	}
 
-	err = s.Definition.Validate()
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleDef.", err)
+	// the job without schedule definition is only triggered by its dependency
+	if s.Definition.Type != "" || s.Dependency == nil {
+		err = s.Definition.Validate()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleDef.", err)
+		}
+	}
+
+	if s.Dependency != nil && s.Dependency.JobName == s.Name {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleJobDependency, the job can't depend on itself.", nil)
 	}
 
 	for _, action := range s.Actions {
@@ -61,14 +75,18 @@ func (s *ScheduleJob) Validate() error {
 }
 
 type ScheduleDef struct {
-	Type           string `json:"type" validate:"oneof='INTERVAL' 'CRON'"`
+	Type           string `json:"type" validate:"oneof='INTERVAL' 'CRON' 'ONCE' 'CALENDAR'"`
 	StartTimestamp int64  `json:"startTimestamp,omitempty"`
 	EndTimestamp   int64  `json:"endTimestamp,omitempty"`
 	// ActiveYearlyTimeWindow is an optional recurring within-year active period; nil means no window constraint.
 	ActiveYearlyTimeWindow *ActiveYearlyTimeWindow `json:"activeYearlyTimeWindow,omitempty" validate:"omitempty"`
+	// TimeZone is an optional IANA time zone in which the schedule is evaluated, e.g. America/New_York.
+	TimeZone string `json:"timeZone,omitempty"`
 
 	IntervalScheduleDef `json:",inline" validate:"-"`
 	CronScheduleDef     `json:",inline" validate:"-"`
+	OnceScheduleDef     `json:",inline" validate:"-"`
+	CalendarScheduleDef `json:",inline" validate:"-"`
 }
 
 // ActiveYearlyTimeWindow is the DTO for a recurring within-year active period. See models.ActiveYearlyTimeWindow
@@ -98,6 +116,19 @@ func (s *ScheduleDef) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid CronScheduleDef.", err)
 		}
+		if _, err = cron.Parse(s.Crontab); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid CronScheduleDef.", err)
+		}
+	case common.DefOnce:
+		err = common.Validate(s.OnceScheduleDef)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid OnceScheduleDef.", err)
+		}
+	case common.DefCalendar:
+		err = common.Validate(s.CalendarScheduleDef)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid CalendarScheduleDef.", err)
+		}
 	}
 
 	if s.EndTimestamp != 0 {
@@ -112,6 +143,12 @@ func (s *ScheduleDef) Validate() error {
 		}
 	}
 
+	if s.TimeZone != "" {
+		if _, err = common.LoadTimeZone(s.TimeZone); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid ScheduleDef.TimeZone.", err)
+		}
+	}
+
 	return nil
 }
 
@@ -159,14 +196,30 @@ type CronScheduleDef struct {
 	Crontab string `json:"crontab,omitempty" validate:"required"`
 }
 
+type OnceScheduleDef struct {
+	FireTimestamp int64 `json:"fireTimestamp,omitempty" validate:"required,gt=0"`
+}
+
+type CalendarScheduleDef struct {
+	FireTimestamps []int64  `json:"fireTimestamps,omitempty" validate:"required,gt=0,dive,gt=0"`
+	ExcludedDates  []string `json:"excludedDates,omitempty" validate:"omitempty,dive,datetime=2006-01-02"`
+}
+
 type ScheduleAction struct {
-	Type        string `json:"type" validate:"oneof='EDGEXMESSAGEBUS' 'REST' 'DEVICECONTROL'"`
+	Type        string `json:"type" validate:"oneof='EDGEXMESSAGEBUS' 'REST' 'DEVICECONTROL' 'NOTIFICATION' 'KVS'"`
 	ContentType string `json:"contentType,omitempty"`
 	Payload     []byte `json:"payload,omitempty"`
+	// RetryCount is the number of retries after the first attempt fails
+	RetryCount int `json:"retryCount,omitempty" validate:"gte=0"`
+	// RetryBackoff is the waiting time before each retry
+	RetryBackoff string `json:"retryBackoff,omitempty" validate:"omitempty,edgex-dto-duration"`
+	// Timeout is the time limit of each attempt
+	Timeout string `json:"timeout,omitempty" validate:"omitempty,edgex-dto-duration"`
 
 	EdgeXMessageBusAction `json:",inline" validate:"-"`
 	RESTAction            `json:",inline" validate:"-"`
 	DeviceControlAction   `json:",inline" validate:"-"`
+	KVSAction             `json:",inline" validate:"-"`
 }
 
 func (s *ScheduleAction) UnmarshalJSON(b []byte) error {
@@ -231,6 +284,23 @@ func (s *ScheduleAction) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DeviceControlAction.", err)
 		}
+	case common.ActionNotification:
+		var notification Notification
+		if err = json.Unmarshal(s.Payload, &notification); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NotificationAction, the payload should be a Notification.", err)
+		}
+		err = common.Validate(notification)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid NotificationAction payload.", err)
+		}
+	case common.ActionKVS:
+		err = common.Validate(s.KVSAction)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KVSAction.", err)
+		}
+		if len(s.Payload) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid KVSAction, the payload should be an UpdateKeysRequest.", nil)
+		}
 	}
 
 	return nil
@@ -252,11 +322,18 @@ type DeviceControlAction struct {
 	SourceName string `json:"sourceName,omitempty" validate:"required"`
 }
 
+// KVSAction updates the values of the key, and the payload should have the shape of requests.UpdateKeysRequest
+type KVSAction struct {
+	Key     string `json:"key,omitempty" validate:"required"`
+	Flatten bool   `json:"flatten,omitempty"`
+}
+
 func ToScheduleJobModel(dto ScheduleJob) models.ScheduleJob {
 	var model models.ScheduleJob
 	model.Id = dto.Id
 	model.Name = dto.Name
 	model.Definition = ToScheduleDefModel(dto.Definition)
+	model.Dependency = ToScheduleJobDependencyModel(dto.Dependency)
 	model.AutoTriggerMissedRecords = dto.AutoTriggerMissedRecords
 	model.Actions = ToScheduleActionModels(dto.Actions)
 	model.AdminState = models.AssignAdminState(dto.AdminState)
@@ -276,6 +353,7 @@ func FromScheduleJobModelToDTO(model models.ScheduleJob) ScheduleJob {
 	dto.Id = model.Id
 	dto.Name = model.Name
 	dto.Definition = FromScheduleDefModelToDTO(model.Definition)
+	dto.Dependency = FromScheduleJobDependencyModelToDTO(model.Dependency)
 	dto.AutoTriggerMissedRecords = model.AutoTriggerMissedRecords
 	dto.Actions = FromScheduleActionModelsToDTOs(model.Actions)
 	dto.AdminState = string(model.AdminState)
@@ -300,6 +378,7 @@ func ToScheduleDefModel(dto ScheduleDef) models.ScheduleDef {
 				StartTimestamp:         dto.StartTimestamp,
 				EndTimestamp:           dto.EndTimestamp,
 				ActiveYearlyTimeWindow: toActiveYearlyTimeWindowModel(dto.ActiveYearlyTimeWindow),
+				TimeZone:               dto.TimeZone,
 			},
 			Interval: dto.Interval,
 		}
@@ -310,9 +389,33 @@ func ToScheduleDefModel(dto ScheduleDef) models.ScheduleDef {
 				StartTimestamp:         dto.StartTimestamp,
 				EndTimestamp:           dto.EndTimestamp,
 				ActiveYearlyTimeWindow: toActiveYearlyTimeWindowModel(dto.ActiveYearlyTimeWindow),
+				TimeZone:               dto.TimeZone,
 			},
 			Crontab: dto.Crontab,
 		}
+	case common.DefOnce:
+		model = models.OnceScheduleDef{
+			BaseScheduleDef: models.BaseScheduleDef{
+				Type:                   common.DefOnce,
+				StartTimestamp:         dto.StartTimestamp,
+				EndTimestamp:           dto.EndTimestamp,
+				ActiveYearlyTimeWindow: toActiveYearlyTimeWindowModel(dto.ActiveYearlyTimeWindow),
+				TimeZone:               dto.TimeZone,
+			},
+			FireTimestamp: dto.FireTimestamp,
+		}
+	case common.DefCalendar:
+		model = models.CalendarScheduleDef{
+			BaseScheduleDef: models.BaseScheduleDef{
+				Type:                   common.DefCalendar,
+				StartTimestamp:         dto.StartTimestamp,
+				EndTimestamp:           dto.EndTimestamp,
+				ActiveYearlyTimeWindow: toActiveYearlyTimeWindowModel(dto.ActiveYearlyTimeWindow),
+				TimeZone:               dto.TimeZone,
+			},
+			FireTimestamps: dto.FireTimestamps,
+			ExcludedDates:  dto.ExcludedDates,
+		}
 	}
 
 	return model
@@ -320,6 +423,9 @@ func ToScheduleDefModel(dto ScheduleDef) models.ScheduleDef {
 
 func FromScheduleDefModelToDTO(model models.ScheduleDef) ScheduleDef {
 	var dto ScheduleDef
+	if model == nil {
+		return dto
+	}
 
 	switch model.GetBaseScheduleDef().Type {
 	case common.DefInterval:
@@ -329,6 +435,7 @@ func FromScheduleDefModelToDTO(model models.ScheduleDef) ScheduleDef {
 			StartTimestamp:         durationModel.StartTimestamp,
 			EndTimestamp:           durationModel.EndTimestamp,
 			ActiveYearlyTimeWindow: fromActiveYearlyTimeWindowModel(durationModel.ActiveYearlyTimeWindow),
+			TimeZone:               durationModel.TimeZone,
 			IntervalScheduleDef:    IntervalScheduleDef{Interval: durationModel.Interval},
 		}
 	case common.DefCron:
@@ -338,8 +445,32 @@ func FromScheduleDefModelToDTO(model models.ScheduleDef) ScheduleDef {
 			StartTimestamp:         cronModel.StartTimestamp,
 			EndTimestamp:           cronModel.EndTimestamp,
 			ActiveYearlyTimeWindow: fromActiveYearlyTimeWindowModel(cronModel.ActiveYearlyTimeWindow),
+			TimeZone:               cronModel.TimeZone,
 			CronScheduleDef:        CronScheduleDef{Crontab: cronModel.Crontab},
 		}
+	case common.DefOnce:
+		onceModel := model.(models.OnceScheduleDef)
+		dto = ScheduleDef{
+			Type:                   common.DefOnce,
+			StartTimestamp:         onceModel.StartTimestamp,
+			EndTimestamp:           onceModel.EndTimestamp,
+			ActiveYearlyTimeWindow: fromActiveYearlyTimeWindowModel(onceModel.ActiveYearlyTimeWindow),
+			TimeZone:               onceModel.TimeZone,
+			OnceScheduleDef:        OnceScheduleDef{FireTimestamp: onceModel.FireTimestamp},
+		}
+	case common.DefCalendar:
+		calendarModel := model.(models.CalendarScheduleDef)
+		dto = ScheduleDef{
+			Type:                   common.DefCalendar,
+			StartTimestamp:         calendarModel.StartTimestamp,
+			EndTimestamp:           calendarModel.EndTimestamp,
+			ActiveYearlyTimeWindow: fromActiveYearlyTimeWindowModel(calendarModel.ActiveYearlyTimeWindow),
+			TimeZone:               calendarModel.TimeZone,
+			CalendarScheduleDef: CalendarScheduleDef{
+				FireTimestamps: calendarModel.FireTimestamps,
+				ExcludedDates:  calendarModel.ExcludedDates,
+			},
+		}
 	}
 
 	return dto
@@ -375,40 +506,49 @@ func ToScheduleActionModel(dto ScheduleAction) models.ScheduleAction {
 	switch dto.Type {
 	case common.ActionEdgeXMessageBus:
 		model = models.EdgeXMessageBusAction{
-			BaseScheduleAction: models.BaseScheduleAction{
-				Type:        common.ActionEdgeXMessageBus,
-				ContentType: dto.ContentType,
-				Payload:     dto.Payload,
-			},
-			Topic:         dto.Topic,
-			UseRawPayload: dto.UseRawPayload,
+			BaseScheduleAction: toBaseScheduleActionModel(common.ActionEdgeXMessageBus, dto),
+			Topic:              dto.Topic,
+			UseRawPayload:      dto.UseRawPayload,
 		}
 	case common.ActionREST:
 		model = models.RESTAction{
-			BaseScheduleAction: models.BaseScheduleAction{
-				Type:        common.ActionREST,
-				ContentType: dto.ContentType,
-				Payload:     dto.Payload,
-			},
-			Method:          dto.Method,
-			Address:         dto.Address,
-			InjectEdgeXAuth: dto.InjectEdgeXAuth,
+			BaseScheduleAction: toBaseScheduleActionModel(common.ActionREST, dto),
+			Method:             dto.Method,
+			Address:            dto.Address,
+			InjectEdgeXAuth:    dto.InjectEdgeXAuth,
 		}
 	case common.ActionDeviceControl:
 		model = models.DeviceControlAction{
-			BaseScheduleAction: models.BaseScheduleAction{
-				Type:        common.ActionDeviceControl,
-				ContentType: dto.ContentType,
-				Payload:     dto.Payload,
-			},
-			DeviceName: dto.DeviceName,
-			SourceName: dto.SourceName,
+			BaseScheduleAction: toBaseScheduleActionModel(common.ActionDeviceControl, dto),
+			DeviceName:         dto.DeviceName,
+			SourceName:         dto.SourceName,
+		}
+	case common.ActionNotification:
+		model = models.NotificationAction{
+			BaseScheduleAction: toBaseScheduleActionModel(common.ActionNotification, dto),
+		}
+	case common.ActionKVS:
+		model = models.KVSAction{
+			BaseScheduleAction: toBaseScheduleActionModel(common.ActionKVS, dto),
+			Key:                dto.Key,
+			Flatten:            dto.Flatten,
 		}
 	}
 
 	return model
 }
 
+func toBaseScheduleActionModel(actionType string, dto ScheduleAction) models.BaseScheduleAction {
+	return models.BaseScheduleAction{
+		Type:         models.ScheduleActionType(actionType),
+		ContentType:  dto.ContentType,
+		Payload:      dto.Payload,
+		RetryCount:   dto.RetryCount,
+		RetryBackoff: dto.RetryBackoff,
+		Timeout:      dto.Timeout,
+	}
+}
+
 func FromScheduleActionModelToDTO(model models.ScheduleAction) ScheduleAction {
 	var dto ScheduleAction
 
@@ -447,8 +587,31 @@ func FromScheduleActionModelToDTO(model models.ScheduleAction) ScheduleAction {
 				SourceName: deviceControlModel.SourceName,
 			},
 		}
+	case common.ActionNotification:
+		notificationModel := model.(models.NotificationAction)
+		dto = ScheduleAction{
+			Type:        common.ActionNotification,
+			ContentType: notificationModel.ContentType,
+			Payload:     notificationModel.Payload,
+		}
+	case common.ActionKVS:
+		kvsModel := model.(models.KVSAction)
+		dto = ScheduleAction{
+			Type:        common.ActionKVS,
+			ContentType: kvsModel.ContentType,
+			Payload:     kvsModel.Payload,
+			KVSAction: KVSAction{
+				Key:     kvsModel.Key,
+				Flatten: kvsModel.Flatten,
+			},
+		}
 	}
 
+	base := model.GetBaseScheduleAction()
+	dto.RetryCount = base.RetryCount
+	dto.RetryBackoff = base.RetryBackoff
+	dto.Timeout = base.Timeout
+
 	return dto
 }
 
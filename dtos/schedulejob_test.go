@@ -6,22 +6,26 @@
 package dtos
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 const (
-	jobName        = "mock-job-name"
-	payload        = "eyJ0ZXN0I"
-	topic          = "mock-topic"
-	crontab        = "0 0 0 1 1 *"
-	startTimestamp = 1724052774
-	endTimestamp   = 1824052774
+	jobName         = "mock-job-name"
+	upstreamJobName = "mock-upstream-job-name"
+	payload         = "eyJ0ZXN0I"
+	topic           = "mock-topic"
+	crontab         = "0 0 0 1 1 *"
+	startTimestamp  = 1724052774
+	endTimestamp    = 1824052774
 )
 
 var scheduleActionEdgeXMessageBus = ScheduleAction{
@@ -45,9 +49,12 @@ var scheduleActionEdgeXMessageBusModel = models.EdgeXMessageBusAction{
 }
 
 var scheduleActionRest = ScheduleAction{
-	Type:        common.ActionREST,
-	ContentType: common.ContentTypeJSON,
-	Payload:     []byte(payload),
+	Type:         common.ActionREST,
+	ContentType:  common.ContentTypeJSON,
+	Payload:      []byte(payload),
+	RetryCount:   3,
+	RetryBackoff: "5s",
+	Timeout:      "30s",
 	RESTAction: RESTAction{
 		Address: testPath,
 		Method:  http.MethodGet,
@@ -56,9 +63,12 @@ var scheduleActionRest = ScheduleAction{
 
 var scheduleActionRestModel = models.RESTAction{
 	BaseScheduleAction: models.BaseScheduleAction{
-		Type:        common.ActionREST,
-		ContentType: common.ContentTypeJSON,
-		Payload:     []byte(payload),
+		Type:         common.ActionREST,
+		ContentType:  common.ContentTypeJSON,
+		Payload:      []byte(payload),
+		RetryCount:   3,
+		RetryBackoff: "5s",
+		Timeout:      "30s",
 	},
 	Address: testPath,
 	Method:  http.MethodGet,
@@ -84,6 +94,46 @@ var scheduleActionDeviceControlModel = models.DeviceControlAction{
 	SourceName: TestSourceName,
 }
 
+const (
+	notificationPayload = `{"category":"shift-report","content":"shift report","sender":"scheduler","severity":"NORMAL"}`
+	kvsPayload          = `{"value":{"Writable":{"LogLevel":"DEBUG"}}}`
+	kvsKey              = "edgex/v4/core-data"
+)
+
+var scheduleActionNotification = ScheduleAction{
+	Type:        common.ActionNotification,
+	ContentType: common.ContentTypeJSON,
+	Payload:     []byte(notificationPayload),
+}
+
+var scheduleActionNotificationModel = models.NotificationAction{
+	BaseScheduleAction: models.BaseScheduleAction{
+		Type:        common.ActionNotification,
+		ContentType: common.ContentTypeJSON,
+		Payload:     []byte(notificationPayload),
+	},
+}
+
+var scheduleActionKVS = ScheduleAction{
+	Type:        common.ActionKVS,
+	ContentType: common.ContentTypeJSON,
+	Payload:     []byte(kvsPayload),
+	KVSAction: KVSAction{
+		Key:     kvsKey,
+		Flatten: true,
+	},
+}
+
+var scheduleActionKVSModel = models.KVSAction{
+	BaseScheduleAction: models.BaseScheduleAction{
+		Type:        common.ActionKVS,
+		ContentType: common.ContentTypeJSON,
+		Payload:     []byte(kvsPayload),
+	},
+	Key:     kvsKey,
+	Flatten: true,
+}
+
 var scheduleIntervalDef = ScheduleDef{
 	Type:           common.DefInterval,
 	StartTimestamp: startTimestamp,
@@ -106,6 +156,7 @@ var scheduleCronDef = ScheduleDef{
 	Type:           common.DefCron,
 	StartTimestamp: startTimestamp,
 	EndTimestamp:   endTimestamp,
+	TimeZone:       "America/New_York",
 	CronScheduleDef: CronScheduleDef{
 		Crontab: crontab,
 	},
@@ -116,16 +167,52 @@ var scheduleCronDefModel = models.CronScheduleDef{
 		Type:           common.DefCron,
 		StartTimestamp: startTimestamp,
 		EndTimestamp:   endTimestamp,
+		TimeZone:       "America/New_York",
 	},
 	Crontab: crontab,
 }
 
+var scheduleOnceDef = ScheduleDef{
+	Type: common.DefOnce,
+	OnceScheduleDef: OnceScheduleDef{
+		FireTimestamp: startTimestamp,
+	},
+}
+
+var scheduleOnceDefModel = models.OnceScheduleDef{
+	BaseScheduleDef: models.BaseScheduleDef{
+		Type: common.DefOnce,
+	},
+	FireTimestamp: startTimestamp,
+}
+
+var scheduleCalendarDef = ScheduleDef{
+	Type:           common.DefCalendar,
+	StartTimestamp: startTimestamp,
+	EndTimestamp:   endTimestamp,
+	CalendarScheduleDef: CalendarScheduleDef{
+		FireTimestamps: []int64{startTimestamp, endTimestamp},
+		ExcludedDates:  []string{"2026-12-25"},
+	},
+}
+
+var scheduleCalendarDefModel = models.CalendarScheduleDef{
+	BaseScheduleDef: models.BaseScheduleDef{
+		Type:           common.DefCalendar,
+		StartTimestamp: startTimestamp,
+		EndTimestamp:   endTimestamp,
+	},
+	FireTimestamps: []int64{startTimestamp, endTimestamp},
+	ExcludedDates:  []string{"2026-12-25"},
+}
+
 var (
 	scheduleJob = ScheduleJob{
 		DBTimestamp:              DBTimestamp{},
 		Id:                       TestUUID,
 		Name:                     jobName,
 		Definition:               scheduleIntervalDef,
+		Dependency:               &ScheduleJobDependency{JobName: upstreamJobName, Condition: common.DependencyOnSucceeded},
 		AutoTriggerMissedRecords: true,
 		Actions:                  []ScheduleAction{scheduleActionEdgeXMessageBus},
 		AdminState:               testAdminState,
@@ -136,6 +223,7 @@ var (
 		Id:                       TestUUID,
 		Name:                     jobName,
 		Definition:               scheduleIntervalDefModel,
+		Dependency:               &models.ScheduleJobDependency{JobName: upstreamJobName, Condition: common.DependencyOnSucceeded},
 		AutoTriggerMissedRecords: true,
 		Actions:                  []models.ScheduleAction{scheduleActionEdgeXMessageBusModel},
 		AdminState:               models.AdminState(testAdminState),
@@ -151,6 +239,11 @@ func TestScheduleJob_Validate(t *testing.T) {
 	emptyName.Name = ""
 	emptyDef := scheduleJob
 	emptyDef.Definition = ScheduleDef{}
+	emptyDef.Dependency = nil
+	dependencyOnly := scheduleJob
+	dependencyOnly.Definition = ScheduleDef{}
+	dependencyOnlyInvalidDef := dependencyOnly
+	dependencyOnlyInvalidDef.Definition = ScheduleDef{Type: common.DefCron}
 	invalidIntervalDef := scheduleJob
 	invalidIntervalDef.Definition = ScheduleDef{
 		Type: common.DefInterval,
@@ -202,6 +295,14 @@ func TestScheduleJob_Validate(t *testing.T) {
 	}
 	invalidAdminState := scheduleJob
 	invalidAdminState.AdminState = "xxx"
+	noDependency := scheduleJob
+	noDependency.Dependency = nil
+	emptyDependencyJobName := scheduleJob
+	emptyDependencyJobName.Dependency = &ScheduleJobDependency{Condition: common.DependencyOnCompleted}
+	invalidDependencyCondition := scheduleJob
+	invalidDependencyCondition.Dependency = &ScheduleJobDependency{JobName: upstreamJobName, Condition: "xxx"}
+	selfDependency := scheduleJob
+	selfDependency.Dependency = &ScheduleJobDependency{JobName: scheduleJob.Name, Condition: common.DependencyOnCompleted}
 
 	tests := []struct {
 		name        string
@@ -212,6 +313,8 @@ func TestScheduleJob_Validate(t *testing.T) {
 		{"invalid ScheduleJob, invalid ID", invalidId, true},
 		{"invalid ScheduleJob, empty Name", emptyName, true},
 		{"invalid ScheduleJob, empty Definition", emptyDef, true},
+		{"valid ScheduleJob, empty Definition with Dependency", dependencyOnly, false},
+		{"invalid ScheduleJob, invalid Definition with Dependency", dependencyOnlyInvalidDef, true},
 		{"invalid ScheduleJob, invalid Interval Definition", invalidIntervalDef, true},
 		{"invalid ScheduleJob, invalid Cron Definition", invalidCronDef, true},
 		{"invalid ScheduleJob, invalid Definition, endTimestamp must be greater than startTimestamp", invalidDef, true},
@@ -220,6 +323,10 @@ func TestScheduleJob_Validate(t *testing.T) {
 		{"invalid ScheduleJob, invalid REST Actions", invalidRestAction, true},
 		{"invalid ScheduleJob, invalid DeviceControl Actions", invalidDeviceControlAction, true},
 		{"invalid ScheduleJob, invalid AdminState", invalidAdminState, true},
+		{"valid ScheduleJob, no Dependency", noDependency, false},
+		{"invalid ScheduleJob, empty Dependency JobName", emptyDependencyJobName, true},
+		{"invalid ScheduleJob, invalid Dependency Condition", invalidDependencyCondition, true},
+		{"invalid ScheduleJob, depends on itself", selfDependency, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -258,6 +365,108 @@ func TestScheduleAction_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestScheduleDef_Validate_Crontab(t *testing.T) {
+	tests := []struct {
+		name        string
+		crontab     string
+		expectedErr string
+	}{
+		{"valid 5 fields", "*/5 * * * *", ""},
+		{"valid 6 fields", "0 */5 * * * MON-FRI", ""},
+		{"valid macro", "@daily", ""},
+		{"valid every macro", "@every 90s", ""},
+		{"valid time zone", "CRON_TZ=Asia/Taipei 0 8 * * *", ""},
+		{"missing field", "*/5 * * *", "expected 5 or 6 fields, got 4"},
+		{"value out of range", "0 24 * * *", "invalid hour field '24': value 24 out of range [0, 23]"},
+		{"unknown macro", "@fortnightly", "unknown macro '@fortnightly'"},
+		{"unknown time zone", "CRON_TZ=Mars/Olympus 0 8 * * *", "unknown time zone 'Mars/Olympus'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := ScheduleDef{
+				Type:            common.DefCron,
+				CronScheduleDef: CronScheduleDef{Crontab: tt.crontab},
+			}
+			err := def.Validate()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestScheduleDef_Validate_TimeZone(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeZone    string
+		expectedErr bool
+	}{
+		{"valid, no time zone", "", false},
+		{"valid, UTC", "UTC", false},
+		{"valid, IANA time zone", "America/New_York", false},
+		{"invalid, Local", "Local", true},
+		{"invalid, unknown time zone", "Mars/Olympus_Mons", true},
+		{"invalid, offset", "+08:00", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := scheduleCronDef
+			def.TimeZone = tt.timeZone
+			err := def.Validate()
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleDef_Validate_OnceAndCalendar(t *testing.T) {
+	invalidOnceDef := scheduleOnceDef
+	invalidOnceDef.FireTimestamp = 0
+	negativeOnceDef := scheduleOnceDef
+	negativeOnceDef.FireTimestamp = -1
+	emptyCalendarDef := scheduleCalendarDef
+	emptyCalendarDef.CalendarScheduleDef = CalendarScheduleDef{}
+	invalidFireTimestamp := scheduleCalendarDef
+	invalidFireTimestamp.CalendarScheduleDef = CalendarScheduleDef{FireTimestamps: []int64{startTimestamp, 0}}
+	invalidExcludedDate := scheduleCalendarDef
+	invalidExcludedDate.CalendarScheduleDef = CalendarScheduleDef{FireTimestamps: []int64{startTimestamp}, ExcludedDates: []string{"2026/12/25"}}
+	noExcludedDates := scheduleCalendarDef
+	noExcludedDates.CalendarScheduleDef = CalendarScheduleDef{FireTimestamps: []int64{startTimestamp}}
+
+	tests := []struct {
+		name        string
+		def         ScheduleDef
+		expectedErr bool
+	}{
+		{"valid ONCE", scheduleOnceDef, false},
+		{"invalid ONCE, empty fireTimestamp", invalidOnceDef, true},
+		{"invalid ONCE, negative fireTimestamp", negativeOnceDef, true},
+		{"valid CALENDAR", scheduleCalendarDef, false},
+		{"valid CALENDAR, no excluded dates", noExcludedDates, false},
+		{"invalid CALENDAR, empty fireTimestamps", emptyCalendarDef, true},
+		{"invalid CALENDAR, invalid fireTimestamp", invalidFireTimestamp, true},
+		{"invalid CALENDAR, invalid excluded date", invalidExcludedDate, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestToScheduleJobModel(t *testing.T) {
 	result := ToScheduleJobModel(scheduleJob)
 	assert.Equal(t, scheduleJobModel, result, "ToScheduleJobModel did not result in ScheduleJob model")
@@ -268,12 +477,36 @@ func TestFromScheduleJobModelToDTO(t *testing.T) {
 	assert.Equal(t, scheduleJob, result, "FromScheduleJobModelToDTO did not result in ScheduleJob dto")
 }
 
+func TestScheduleJob_DependencyOnlyConversion(t *testing.T) {
+	dto := scheduleJob
+	dto.Definition = ScheduleDef{}
+
+	model := ToScheduleJobModel(dto)
+	assert.Nil(t, model.Definition)
+	assert.Equal(t, dto, FromScheduleJobModelToDTO(model))
+
+	data, err := json.Marshal(dto)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"definition"`)
+
+	var unmarshaled models.ScheduleJob
+	require.NoError(t, json.Unmarshal(data, &unmarshaled))
+	assert.Nil(t, unmarshaled.Definition)
+	assert.Equal(t, model.Dependency, unmarshaled.Dependency)
+}
+
 func TestToScheduleDefModel(t *testing.T) {
 	result := ToScheduleDefModel(scheduleIntervalDef)
 	assert.Equal(t, scheduleIntervalDefModel, result, "ToScheduleDefModel did not result in Interval ScheduleDef model")
 
 	result2 := ToScheduleDefModel(scheduleCronDef)
 	assert.Equal(t, scheduleCronDefModel, result2, "ToScheduleDefModel did not result in Cron ScheduleDef model")
+
+	result3 := ToScheduleDefModel(scheduleOnceDef)
+	assert.Equal(t, scheduleOnceDefModel, result3, "ToScheduleDefModel did not result in Once ScheduleDef model")
+
+	result4 := ToScheduleDefModel(scheduleCalendarDef)
+	assert.Equal(t, scheduleCalendarDefModel, result4, "ToScheduleDefModel did not result in Calendar ScheduleDef model")
 }
 
 func TestFromScheduleDefModelToDTO(t *testing.T) {
@@ -282,6 +515,84 @@ func TestFromScheduleDefModelToDTO(t *testing.T) {
 
 	result2 := FromScheduleDefModelToDTO(scheduleCronDefModel)
 	assert.Equal(t, scheduleCronDef, result2, "FromScheduleDefModelToDTO did not result in Cron ScheduleDef dto")
+
+	result3 := FromScheduleDefModelToDTO(scheduleOnceDefModel)
+	assert.Equal(t, scheduleOnceDef, result3, "FromScheduleDefModelToDTO did not result in Once ScheduleDef dto")
+
+	result4 := FromScheduleDefModelToDTO(scheduleCalendarDefModel)
+	assert.Equal(t, scheduleCalendarDef, result4, "FromScheduleDefModelToDTO did not result in Calendar ScheduleDef dto")
+}
+
+func TestScheduleAction_Validate_NotificationAndKVS(t *testing.T) {
+	invalidNotificationPayload := scheduleActionNotification
+	invalidNotificationPayload.Payload = []byte(payload)
+	noNotificationPayload := scheduleActionNotification
+	noNotificationPayload.Payload = nil
+	invalidNotification := scheduleActionNotification
+	invalidNotification.Payload = []byte(`{"category":"shift-report","content":"shift report","sender":"scheduler","severity":"UNKNOWN"}`)
+	noKey := scheduleActionKVS
+	noKey.KVSAction = KVSAction{}
+	noKVSPayload := scheduleActionKVS
+	noKVSPayload.Payload = nil
+
+	tests := []struct {
+		name        string
+		action      ScheduleAction
+		expectedErr bool
+	}{
+		{"valid NOTIFICATION", scheduleActionNotification, false},
+		{"invalid NOTIFICATION, payload is not a Notification", invalidNotificationPayload, true},
+		{"invalid NOTIFICATION, no payload", noNotificationPayload, true},
+		{"invalid NOTIFICATION, invalid severity", invalidNotification, true},
+		{"valid KVS", scheduleActionKVS, false},
+		{"invalid KVS, no key", noKey, true},
+		{"invalid KVS, no payload", noKVSPayload, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.action.Validate()
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleAction_Validate_RetryPolicy(t *testing.T) {
+	noRetry := scheduleActionRest
+	noRetry.RetryCount = 0
+	noRetry.RetryBackoff = ""
+	noRetry.Timeout = ""
+	negativeRetryCount := scheduleActionRest
+	negativeRetryCount.RetryCount = -1
+	invalidRetryBackoff := scheduleActionRest
+	invalidRetryBackoff.RetryBackoff = "5"
+	invalidTimeout := scheduleActionRest
+	invalidTimeout.Timeout = "xyz"
+
+	tests := []struct {
+		name        string
+		action      ScheduleAction
+		expectedErr bool
+	}{
+		{"valid, with retry policy and timeout", scheduleActionRest, false},
+		{"valid, without retry policy and timeout", noRetry, false},
+		{"invalid, negative RetryCount", negativeRetryCount, true},
+		{"invalid, invalid RetryBackoff", invalidRetryBackoff, true},
+		{"invalid, invalid Timeout", invalidTimeout, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.action.Validate()
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestToScheduleActionModel(t *testing.T) {
@@ -293,6 +604,12 @@ func TestToScheduleActionModel(t *testing.T) {
 
 	result3 := ToScheduleActionModel(scheduleActionDeviceControl)
 	assert.Equal(t, scheduleActionDeviceControlModel, result3, "ToScheduleActionModel did not result in DeviceControl ScheduleAction model")
+
+	result4 := ToScheduleActionModel(scheduleActionNotification)
+	assert.Equal(t, scheduleActionNotificationModel, result4, "ToScheduleActionModel did not result in Notification ScheduleAction model")
+
+	result5 := ToScheduleActionModel(scheduleActionKVS)
+	assert.Equal(t, scheduleActionKVSModel, result5, "ToScheduleActionModel did not result in KVS ScheduleAction model")
 }
 
 func TestFromScheduleActionModelToDTO(t *testing.T) {
@@ -304,6 +621,12 @@ func TestFromScheduleActionModelToDTO(t *testing.T) {
 
 	result3 := FromScheduleActionModelToDTO(scheduleActionDeviceControlModel)
 	assert.Equal(t, scheduleActionDeviceControl, result3, "FromScheduleActionModelToDTO did not result in DeviceControl ScheduleAction dto")
+
+	result4 := FromScheduleActionModelToDTO(scheduleActionNotificationModel)
+	assert.Equal(t, scheduleActionNotification, result4, "FromScheduleActionModelToDTO did not result in Notification ScheduleAction dto")
+
+	result5 := FromScheduleActionModelToDTO(scheduleActionKVSModel)
+	assert.Equal(t, scheduleActionKVS, result5, "FromScheduleActionModelToDTO did not result in KVS ScheduleAction dto")
 }
 
 func TestActiveYearlyTimeWindow_Validate(t *testing.T) {
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// ScheduleJobDependency triggers a ScheduleJob when its upstream job completes
+type ScheduleJobDependency struct {
+	JobName   string `json:"jobName" validate:"edgex-dto-none-empty-string"`
+	Condition string `json:"condition" validate:"oneof='COMPLETED' 'SUCCEEDED'"`
+}
+
+// ScheduleJobDependencyGraph describes the dependencies between schedule jobs
+type ScheduleJobDependencyGraph struct {
+	// Jobs are the names of the jobs in the graph, sorted by name
+	Jobs  []string                    `json:"jobs"`
+	Edges []ScheduleJobDependencyEdge `json:"edges"`
+}
+
+// ScheduleJobDependencyEdge is the dependency of a job on its upstream job
+type ScheduleJobDependencyEdge struct {
+	JobName   string `json:"jobName"`
+	DependsOn string `json:"dependsOn"`
+	Condition string `json:"condition"`
+}
+
+// IsEmpty returns true if neither the upstream job nor the condition is specified, which removes the dependency in
+// UpdateScheduleJob
+func (d ScheduleJobDependency) IsEmpty() bool {
+	return d == ScheduleJobDependency{}
+}
+
+func ToScheduleJobDependencyModel(dto *ScheduleJobDependency) *models.ScheduleJobDependency {
+	if dto == nil {
+		return nil
+	}
+	return &models.ScheduleJobDependency{
+		JobName:   dto.JobName,
+		Condition: models.ScheduleJobDependencyCondition(dto.Condition),
+	}
+}
+
+func FromScheduleJobDependencyModelToDTO(model *models.ScheduleJobDependency) *ScheduleJobDependency {
+	if model == nil {
+		return nil
+	}
+	return &ScheduleJobDependency{
+		JobName:   model.JobName,
+		Condition: string(model.Condition),
+	}
+}
+
+// NewScheduleJobDependencyGraph builds the dependency graph of the jobs. The upstream jobs which are not in the
+// specified jobs are also included in the graph.
+func NewScheduleJobDependencyGraph(jobs []models.ScheduleJob) ScheduleJobDependencyGraph {
+	graph := ScheduleJobDependencyGraph{
+		Jobs:  make([]string, 0, len(jobs)),
+		Edges: make([]ScheduleJobDependencyEdge, 0),
+	}
+	for _, job := range jobs {
+		graph.Jobs = append(graph.Jobs, job.Name)
+		if job.Dependency == nil {
+			continue
+		}
+		graph.Jobs = append(graph.Jobs, job.Dependency.JobName)
+		graph.Edges = append(graph.Edges, ScheduleJobDependencyEdge{
+			JobName:   job.Name,
+			DependsOn: job.Dependency.JobName,
+			Condition: string(job.Dependency.Condition),
+		})
+	}
+	slices.Sort(graph.Jobs)
+	graph.Jobs = slices.Compact(graph.Jobs)
+	return graph
+}
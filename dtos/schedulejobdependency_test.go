@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestScheduleJobDependencyConversion(t *testing.T) {
+	dto := &ScheduleJobDependency{JobName: upstreamJobName, Condition: common.DependencyOnCompleted}
+	model := &models.ScheduleJobDependency{JobName: upstreamJobName, Condition: common.DependencyOnCompleted}
+
+	assert.Equal(t, model, ToScheduleJobDependencyModel(dto))
+	assert.Equal(t, dto, FromScheduleJobDependencyModelToDTO(model))
+	assert.Nil(t, ToScheduleJobDependencyModel(nil))
+	assert.Nil(t, FromScheduleJobDependencyModelToDTO(nil))
+}
+
+func TestNewScheduleJobDependencyGraph(t *testing.T) {
+	jobs := []models.ScheduleJob{
+		{Name: "export", Dependency: &models.ScheduleJobDependency{JobName: "flush", Condition: common.DependencyOnSucceeded}},
+		{Name: "notify", Dependency: &models.ScheduleJobDependency{JobName: "export", Condition: common.DependencyOnCompleted}},
+		{Name: "standalone"},
+	}
+
+	tests := []struct {
+		name     string
+		jobs     []models.ScheduleJob
+		expected ScheduleJobDependencyGraph
+	}{
+		{"chain with an upstream job not in the jobs", jobs, ScheduleJobDependencyGraph{
+			Jobs: []string{"export", "flush", "notify", "standalone"},
+			Edges: []ScheduleJobDependencyEdge{
+				{JobName: "export", DependsOn: "flush", Condition: common.DependencyOnSucceeded},
+				{JobName: "notify", DependsOn: "export", Condition: common.DependencyOnCompleted},
+			},
+		}},
+		{"no jobs", nil, ScheduleJobDependencyGraph{Jobs: []string{}, Edges: []ScheduleJobDependencyEdge{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewScheduleJobDependencyGraph(tt.jobs))
+		})
+	}
+}
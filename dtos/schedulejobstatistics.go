@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// ScheduleJobStatistics summarizes the schedule action records of a ScheduleJob within a time range
+type ScheduleJobStatistics struct {
+	JobName string `json:"jobName"`
+	// Start and End are the time range of the statistics in milliseconds
+	Start          int64 `json:"start"`
+	End            int64 `json:"end"`
+	SucceededCount int64 `json:"succeededCount"`
+	FailedCount    int64 `json:"failedCount"`
+	MissedCount    int64 `json:"missedCount"`
+	// LastSucceeded is the ScheduledAt of the latest succeeded record in milliseconds, or 0 if there is none
+	LastSucceeded int64 `json:"lastSucceeded,omitempty"`
+	// AverageLatency is the average time from ScheduledAt to Created of the succeeded and failed records in milliseconds
+	AverageLatency int64 `json:"averageLatency"`
+}
@@ -1,11 +1,15 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package dtos
 
 import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
@@ -21,19 +25,84 @@ type Subscription struct {
 	ResendLimit    int       `json:"resendLimit,omitempty"`
 	ResendInterval string    `json:"resendInterval,omitempty" validate:"omitempty,edgex-dto-duration"`
 	AdminState     string    `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
+	// TemplateName is the optional name of the NotificationTemplate which renders the notifications for the channels
+	TemplateName string `json:"templateName,omitempty" validate:"omitempty,edgex-dto-none-empty-string"`
+	// Template is the legacy inline template, which is only used without the TemplateName and can't be specified
+	// along with the TemplateName. It is validated by AddSubscriptionRequest.Validate.
+	Template *NotificationTemplate `json:"template,omitempty" validate:"-"`
+	// GroupingKeys are the Notification fields identifying the repeated notifications, which are collapsed into one
+	// transmission with the occurrence count
+	GroupingKeys []string `json:"groupingKeys,omitempty" validate:"omitempty,unique,dive,oneof='category' 'labels' 'sender' 'severity' 'content' 'dedupKey'"`
+	// TimeZone is the optional IANA time zone in which the QuietHours are evaluated
+	TimeZone string `json:"timeZone,omitempty"`
+	// QuietHours are the daily time windows in which the notifications are held until the windows end
+	QuietHours []DailyTimeWindow `json:"quietHours,omitempty" validate:"omitempty,dive"`
+	// QuietHoursOverrideSeverity is the minimum Severity of the notifications delivered within the QuietHours
+	QuietHoursOverrideSeverity string `json:"quietHoursOverrideSeverity,omitempty" validate:"omitempty,oneof='MINOR' 'NORMAL' 'CRITICAL'"`
+	// EscalationPolicy is the optional multi-level escalation of the unacknowledged notifications
+	EscalationPolicy *EscalationPolicy `json:"escalationPolicy,omitempty"`
+	// Digest batches the notifications into one digest on the interval instead of sending them one by one
+	Digest *Digest `json:"digest,omitempty"`
 }
 
+// UpdateSubscription is the patch of a Subscription. An empty TemplateName removes the template reference. The
+// Template, EscalationPolicy and Digest replace those of the subscription, and an empty object removes them. They are
+// validated by UpdateSubscriptionRequest.Validate.
 type UpdateSubscription struct {
-	Id             *string   `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
-	Name           *string   `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
-	Channels       []Address `json:"channels" validate:"omitempty,gt=0,dive"`
-	Receiver       *string   `json:"receiver" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
-	Categories     []string  `json:"categories" validate:"omitempty,dive,gt=0,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
-	Labels         []string  `json:"labels" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
-	Description    *string   `json:"description"`
-	ResendLimit    *int      `json:"resendLimit"`
-	ResendInterval *string   `json:"resendInterval" validate:"omitempty,edgex-dto-duration"`
-	AdminState     *string   `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	Id                         *string               `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name                       *string               `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Channels                   []Address             `json:"channels" validate:"omitempty,gt=0,dive"`
+	Receiver                   *string               `json:"receiver" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Categories                 []string              `json:"categories" validate:"omitempty,dive,gt=0,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Labels                     []string              `json:"labels" validate:"omitempty,dive,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description                *string               `json:"description"`
+	ResendLimit                *int                  `json:"resendLimit"`
+	ResendInterval             *string               `json:"resendInterval" validate:"omitempty,edgex-dto-duration"`
+	AdminState                 *string               `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	TemplateName               *string               `json:"templateName" validate:"omitempty,len=0|edgex-dto-none-empty-string"`
+	Template                   *NotificationTemplate `json:"template" validate:"-"`
+	GroupingKeys               []string              `json:"groupingKeys" validate:"omitempty,unique,dive,oneof='category' 'labels' 'sender' 'severity' 'content' 'dedupKey'"`
+	TimeZone                   *string               `json:"timeZone"`
+	QuietHours                 []DailyTimeWindow     `json:"quietHours" validate:"omitempty,dive"`
+	QuietHoursOverrideSeverity *string               `json:"quietHoursOverrideSeverity" validate:"omitempty,oneof='MINOR' 'NORMAL' 'CRITICAL'"`
+	EscalationPolicy           *EscalationPolicy     `json:"escalationPolicy" validate:"-"`
+	Digest                     *Digest               `json:"digest" validate:"-"`
+}
+
+// Digest batches the notifications matching a Subscription on the Interval, and lists at most MaxItems of them in
+// the digest content, or all of them if MaxItems is 0.
+type Digest struct {
+	Interval string `json:"interval" validate:"required,edgex-dto-duration=1m"`
+	MaxItems int    `json:"maxItems,omitempty" validate:"min=0"`
+}
+
+// IsEmpty returns true if neither the interval nor the max items is specified, which removes the digest in UpdateSubscription
+func (d Digest) IsEmpty() bool {
+	return d == Digest{}
+}
+
+// DailyTimeWindow is a recurring daily time range from the start time inclusive to the end time exclusive. The
+// window crosses midnight if the start time is after the end time.
+type DailyTimeWindow struct {
+	StartHour   int `json:"startHour" validate:"min=0,max=23"`
+	StartMinute int `json:"startMinute" validate:"min=0,max=59"`
+	EndHour     int `json:"endHour" validate:"min=0,max=23"`
+	EndMinute   int `json:"endMinute" validate:"min=0,max=59"`
+}
+
+// Validate satisfies the Validator interface. The start time equal to the end time is rejected because the window
+// would be either empty or the whole day.
+func (w *DailyTimeWindow) Validate() error {
+	err := common.Validate(w)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DailyTimeWindow.", err)
+	}
+	if w.StartHour == w.EndHour && w.StartMinute == w.EndMinute {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("invalid daily time window %02d:%02d-%02d:%02d: the start time must not equal the end time",
+				w.StartHour, w.StartMinute, w.EndHour, w.EndMinute), nil)
+	}
+	return nil
 }
 
 // ToSubscriptionModel transforms the Subscription DTO to the Subscription Model
@@ -50,6 +119,23 @@ func ToSubscriptionModel(s Subscription) models.Subscription {
 	m.ResendLimit = s.ResendLimit
 	m.ResendInterval = s.ResendInterval
 	m.AdminState = models.AdminState(s.AdminState)
+	m.TemplateName = s.TemplateName
+	m.GroupingKeys = s.GroupingKeys
+	m.TimeZone = s.TimeZone
+	m.QuietHours = ToDailyTimeWindowModels(s.QuietHours)
+	m.QuietHoursOverrideSeverity = models.NotificationSeverity(s.QuietHoursOverrideSeverity)
+	if s.EscalationPolicy != nil {
+		policy := ToEscalationPolicyModel(*s.EscalationPolicy)
+		m.EscalationPolicy = &policy
+	}
+	if s.Digest != nil {
+		digest := models.Digest(*s.Digest)
+		m.Digest = &digest
+	}
+	if s.Template != nil {
+		template := ToNotificationTemplateModel(*s.Template)
+		m.Template = &template
+	}
 	return m
 }
 
@@ -64,7 +150,7 @@ func ToSubscriptionModels(subs []Subscription) []models.Subscription {
 
 // FromSubscriptionModelToDTO transforms the Subscription Model to the Subscription DTO
 func FromSubscriptionModelToDTO(s models.Subscription) Subscription {
-	return Subscription{
+	dto := Subscription{
 		DBTimestamp:    DBTimestamp(s.DBTimestamp),
 		Categories:     s.Categories,
 		Labels:         s.Labels,
@@ -76,7 +162,26 @@ func FromSubscriptionModelToDTO(s models.Subscription) Subscription {
 		ResendLimit:    s.ResendLimit,
 		ResendInterval: s.ResendInterval,
 		AdminState:     string(s.AdminState),
+		TemplateName:   s.TemplateName,
+		GroupingKeys:   s.GroupingKeys,
+
+		TimeZone:                   s.TimeZone,
+		QuietHours:                 FromDailyTimeWindowModelsToDTOs(s.QuietHours),
+		QuietHoursOverrideSeverity: string(s.QuietHoursOverrideSeverity),
+	}
+	if s.Template != nil {
+		template := FromNotificationTemplateModelToDTO(*s.Template)
+		dto.Template = &template
+	}
+	if s.EscalationPolicy != nil {
+		policy := FromEscalationPolicyModelToDTO(*s.EscalationPolicy)
+		dto.EscalationPolicy = &policy
 	}
+	if s.Digest != nil {
+		digest := Digest(*s.Digest)
+		dto.Digest = &digest
+	}
+	return dto
 }
 
 // FromSubscriptionModelsToDTOs transforms the Subscription model array to the Subscription DTO array
@@ -87,3 +192,27 @@ func FromSubscriptionModelsToDTOs(subscruptions []models.Subscription) []Subscri
 	}
 	return dtos
 }
+
+// ToDailyTimeWindowModels transforms the DailyTimeWindow DTO array to the DailyTimeWindow model array
+func ToDailyTimeWindowModels(windows []DailyTimeWindow) []models.DailyTimeWindow {
+	if windows == nil {
+		return nil
+	}
+	result := make([]models.DailyTimeWindow, len(windows))
+	for i, w := range windows {
+		result[i] = models.DailyTimeWindow(w)
+	}
+	return result
+}
+
+// FromDailyTimeWindowModelsToDTOs transforms the DailyTimeWindow model array to the DailyTimeWindow DTO array
+func FromDailyTimeWindowModelsToDTOs(windows []models.DailyTimeWindow) []DailyTimeWindow {
+	if windows == nil {
+		return nil
+	}
+	result := make([]DailyTimeWindow, len(windows))
+	for i, w := range windows {
+		result[i] = DailyTimeWindow(w)
+	}
+	return result
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -18,6 +18,7 @@ type Transmission struct {
 	Records          []TransmissionRecord `json:"records,omitempty"`
 	ResendCount      int                  `json:"resendCount,omitempty"`
 	Status           string               `json:"status" validate:"required,oneof='ACKNOWLEDGED' 'FAILED' 'SENT' 'ESCALATED' 'RESENDING'"`
+	OccurrenceCount  int                  `json:"occurrenceCount,omitempty" validate:"gte=0"`
 }
 
 // ToTransmissionModel transforms a Transmission DTO to a Transmission Model
@@ -31,6 +32,7 @@ func ToTransmissionModel(trans Transmission) models.Transmission {
 	m.Records = ToTransmissionRecordModels(trans.Records)
 	m.ResendCount = trans.ResendCount
 	m.Status = models.TransmissionStatus(trans.Status)
+	m.OccurrenceCount = trans.OccurrenceCount
 	return m
 }
 
@@ -54,6 +56,7 @@ func FromTransmissionModelToDTO(trans models.Transmission) Transmission {
 		Records:          FromTransmissionRecordModelsToDTOs(trans.Records),
 		ResendCount:      trans.ResendCount,
 		Status:           string(trans.Status),
+		OccurrenceCount:  trans.OccurrenceCount,
 	}
 }
 
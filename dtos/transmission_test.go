@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestTransmissionConversion(t *testing.T) {
+	trans := Transmission{
+		Created:          TestTimestamp,
+		Id:               TestUUID,
+		Channel:          NewEmailAddress([]string{"test@example.com"}),
+		NotificationId:   TestUUID,
+		SubscriptionName: "subscription",
+		Records:          []TransmissionRecord{{Status: string(models.Sent), Sent: TestTimestamp}},
+		Status:           string(models.Sent),
+		OccurrenceCount:  5,
+	}
+	m := ToTransmissionModel(trans)
+	assert.Equal(t, 5, m.OccurrenceCount)
+	assert.Equal(t, trans, FromTransmissionModelToDTO(m))
+}
+
+func TestTransmission_Validate(t *testing.T) {
+	valid := Transmission{
+		Channel:          NewEmailAddress([]string{"test@example.com"}),
+		NotificationId:   TestUUID,
+		SubscriptionName: "subscription",
+		Status:           string(models.Sent),
+		OccurrenceCount:  5,
+	}
+	negativeCount := valid
+	negativeCount.OccurrenceCount = -1
+
+	require.NoError(t, common.Validate(valid))
+	require.Error(t, common.Validate(negativeCount))
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -15,6 +15,15 @@ type TransmissionRecord struct {
 	Status   string `json:"status,omitempty" validate:"omitempty,oneof='ACKNOWLEDGED' 'FAILED' 'SENT' 'ESCALATED'"`
 	Response string `json:"response,omitempty"`
 	Sent     int64  `json:"sent,omitempty"`
+	// Error is the structured error of the failed transmission attempt
+	Error *TransmissionError `json:"error,omitempty"`
+}
+
+// TransmissionError describes why a transmission attempt failed
+type TransmissionError struct {
+	StatusCode int    `json:"statusCode,omitempty" validate:"gte=0"`
+	Kind       string `json:"kind" validate:"required,edgex-dto-none-empty-string"`
+	Retryable  bool   `json:"retryable"`
 }
 
 // String returns a JSON encoded string representation of the object
@@ -32,6 +41,10 @@ func ToTransmissionRecordModel(tr TransmissionRecord) models.TransmissionRecord
 	m.Status = models.TransmissionStatus(tr.Status)
 	m.Response = tr.Response
 	m.Sent = tr.Sent
+	if tr.Error != nil {
+		e := models.TransmissionError(*tr.Error)
+		m.Error = &e
+	}
 	return m
 }
 
@@ -46,11 +59,16 @@ func ToTransmissionRecordModels(trs []TransmissionRecord) []models.TransmissionR
 
 // FromTransmissionRecordModelToDTO transforms a TransmissionRecord Model to a TransmissionRecord DTO
 func FromTransmissionRecordModelToDTO(tr models.TransmissionRecord) TransmissionRecord {
-	return TransmissionRecord{
+	dto := TransmissionRecord{
 		Status:   string(tr.Status),
 		Response: tr.Response,
 		Sent:     tr.Sent,
 	}
+	if tr.Error != nil {
+		e := TransmissionError(*tr.Error)
+		dto.Error = &e
+	}
+	return dto
 }
 
 // FromTransmissionRecordModelsToDTOs transforms a TransmissionRecord model array to a TransmissionRecord DTO array
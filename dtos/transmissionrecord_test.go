@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestTransmissionRecordConversion(t *testing.T) {
+	failed := TransmissionRecord{
+		Status:   string(models.Failed),
+		Response: "service unavailable",
+		Sent:     TestTimestamp,
+		Error:    &TransmissionError{StatusCode: http.StatusServiceUnavailable, Kind: string(errors.KindServiceUnavailable), Retryable: true},
+	}
+	failedModel := models.TransmissionRecord{
+		Status:   models.Failed,
+		Response: "service unavailable",
+		Sent:     TestTimestamp,
+		Error:    &models.TransmissionError{StatusCode: http.StatusServiceUnavailable, Kind: string(errors.KindServiceUnavailable), Retryable: true},
+	}
+	sent := TransmissionRecord{Status: string(models.Sent), Sent: TestTimestamp}
+	sentModel := models.TransmissionRecord{Status: models.Sent, Sent: TestTimestamp}
+
+	assert.Equal(t, []models.TransmissionRecord{failedModel, sentModel}, ToTransmissionRecordModels([]TransmissionRecord{failed, sent}))
+	assert.Equal(t, []TransmissionRecord{failed, sent}, FromTransmissionRecordModelsToDTOs([]models.TransmissionRecord{failedModel, sentModel}))
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"text/template"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// webhookSignaturePrefix identifies the algorithm of the webhook signature
+const webhookSignaturePrefix = "sha256="
+
+// ParseWebhookBodyTemplate parses the body template of the WEBHOOK address and checks that the fields it refers to
+// exist in the Notification DTO. The template is executed with the Notification DTO, e.g.
+// {"text": {{ json .Content }}, "severity": "{{ .Severity }}"}.
+func ParseWebhookBodyTemplate(text string) (*template.Template, errors.EdgeX) {
+	tmpl, err := template.New("webhookBody").Funcs(notificationTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the webhook body template", err)
+	}
+	if edgexErr := checkNotificationTemplateFields(tmpl); edgexErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid webhook body template", edgexErr)
+	}
+	return tmpl, nil
+}
+
+// RenderWebhookBody renders the webhook request body of the notification with the body template. The notification
+// content is returned as is if the body template is empty. The body template referring to {{ ackToken }} must be
+// rendered by RenderWebhookBodyWithAckToken instead.
+func RenderWebhookBody(bodyTemplate string, notification Notification) ([]byte, errors.EdgeX) {
+	return RenderWebhookBodyWithAckToken(bodyTemplate, notification, "")
+}
+
+// RenderWebhookBodyWithAckToken renders the webhook request body like RenderWebhookBody, and the {{ ackToken }} in the
+// body template renders the ack token, which is usually generated by GenerateAckToken for the webhook
+func RenderWebhookBodyWithAckToken(bodyTemplate string, notification Notification, ackToken string) ([]byte, errors.EdgeX) {
+	if bodyTemplate == "" {
+		return []byte(notification.Content), nil
+	}
+	tmpl, err := ParseWebhookBodyTemplate(bodyTemplate)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	tmpl = tmpl.Funcs(template.FuncMap{"ackToken": ackTokenFunc(ackToken)})
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, notification); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to render the webhook body template", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// SignWebhookBody returns the HMAC-SHA256 signature of the webhook request body in the format of sha256=<hex digest>
+func SignWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks the signature of the webhook request body in constant time
+func VerifyWebhookSignature(secret, body []byte, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, webhookSignaturePrefix)
+	if !ok {
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+func TestRenderWebhookBody(t *testing.T) {
+	notification := Notification{
+		Category: "health-check",
+		Labels:   []string{"device", "offline"},
+		Content:  `Device "pump-1" is offline`,
+		Sender:   "core-metadata",
+		Severity: models.Critical,
+	}
+
+	tests := []struct {
+		name         string
+		bodyTemplate string
+		expected     string
+		expectError  bool
+	}{
+		{"no template", "", `Device "pump-1" is offline`, false},
+		{"plain text", "[{{ .Severity }}] {{ .Category }}: {{ .Content }}", `[CRITICAL] health-check: Device "pump-1" is offline`, false},
+		{"JSON with escaped content", `{"text":{{ json .Content }},"labels":{{ json .Labels }}}`,
+			`{"text":"Device \"pump-1\" is offline","labels":["device","offline"]}`, false},
+		{"syntax error", "{{ .Content ", "", true},
+		{"unknown field", "{{ .Message }}", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := RenderWebhookBody(tt.bodyTemplate, notification)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(body))
+		})
+	}
+}
+
+func TestParseWebhookBodyTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		bodyTemplate string
+		expectError  bool
+	}{
+		{"valid, fields", `{"text":{{ json .Content }},"created":{{ .Created }}}`, false},
+		{"valid, index of the labels", `{"label":"{{ index .Labels 0 }}"}`, false},
+		{"valid, fields of the range element and $", `{{ range .Labels }}{{ . }} from {{ $.Sender }} {{ end }}`, false},
+		{"valid, fields of the with value", `{{ with .Labels }}{{ .Unknown }}{{ else }}{{ .Category }}{{ end }}`, false},
+		{"valid, field of the if condition", `{{ if eq .Severity "CRITICAL" }}{{ .Content }}{{ end }}`, false},
+		{"invalid, syntax error", `{{ .Content `, true},
+		{"invalid, unknown field", `{{ .Message }}`, true},
+		{"invalid, unknown field of $ in range", `{{ range .Labels }}{{ $.Message }}{{ end }}`, true},
+		{"invalid, unknown field in if", `{{ if .Acknowledged }}{{ .Message }}{{ end }}`, true},
+		{"invalid, unknown field in function argument", `{{ json .Message }}`, true},
+		{"invalid, unknown field in range else", `{{ range .Labels }}{{ . }}{{ else }}{{ .Message }}{{ end }}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseWebhookBodyTemplate(tt.bodyTemplate)
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected ParseWebhookBodyTemplate result.", err)
+		})
+	}
+}
+
+func TestSignWebhookBody(t *testing.T) {
+	secret := []byte("It's a Secret to Everybody")
+	body := []byte("Hello, World!")
+	// the test vector is from the GitHub webhook documentation
+	expected := "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17"
+
+	signature := SignWebhookBody(secret, body)
+	assert.Equal(t, expected, signature)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		body      []byte
+		signature string
+		expected  bool
+	}{
+		{"valid", secret, body, expected, true},
+		{"wrong secret", []byte("another secret"), body, expected, false},
+		{"tampered body", secret, []byte("Hello, World?"), expected, false},
+		{"missing prefix", secret, body, expected[len("sha256="):], false},
+		{"invalid hex digest", secret, body, "sha256=xyz", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, VerifyWebhookSignature(tt.secret, tt.body, tt.signature))
+		})
+	}
+}
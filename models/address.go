@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -57,6 +57,24 @@ func unmarshalAddress(b []byte) (address Address, err error) {
 			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal ZeroMQ address.", err)
 		}
 		address = zeromq
+	case common.WEBHOOK:
+		var webhook WebhookAddress
+		if err = json.Unmarshal(b, &webhook); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal WEBHOOK address.", err)
+		}
+		address = webhook
+	case common.KAFKA:
+		var kafka KafkaAddress
+		if err = json.Unmarshal(b, &kafka); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal KAFKA address.", err)
+		}
+		address = kafka
+	case common.NATS:
+		var nats NATSAddress
+		if err = json.Unmarshal(b, &nats); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal NATS address.", err)
+		}
+		address = nats
 	default:
 		return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported address type", err)
 	}
@@ -72,6 +90,9 @@ type BaseAddress struct {
 	Scheme string // Scheme indicates the scheme of the URI, see https://en.wikipedia.org/wiki/Uniform_Resource_Identifier#Syntax
 	Host   string
 	Port   int
+
+	// MinSeverity is the minimum Severity of the notifications delivered to the address, the default is all
+	MinSeverity NotificationSeverity
 }
 
 // Security is a base struct contains the security related fields.
@@ -132,3 +153,52 @@ type ZeroMQAddress struct {
 }
 
 func (a ZeroMQAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
+
+// WebhookAddress is a webhook specific struct, which sends the notification in an HTTP request with custom headers
+// and a templated body
+type WebhookAddress struct {
+	BaseAddress
+	Path       string
+	HTTPMethod string
+	Headers    map[string]string
+	// BodyTemplate is the Go text/template rendering the Notification as the request body. The Notification content
+	// is sent as is if the template is empty.
+	BodyTemplate string
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of the request body, X-EdgeX-Signature by default
+	SignatureHeader string
+	// SecretPath is the path in the secret provider to retrieve the signing secret. The request is not signed if empty.
+	SecretPath string
+}
+
+func (a WebhookAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
+
+// KafkaAddress is a Kafka specific struct, which publishes the notification to the Topic of the Kafka cluster
+type KafkaAddress struct {
+	BaseAddress
+	MessageBus
+	Security
+	// Brokers are the host:port addresses of the bootstrap brokers
+	Brokers []string
+	// Headers are the custom headers added to the published messages
+	Headers  map[string]string
+	ClientId string
+	// PartitionKey is the key of the published messages, which decides the partition of the Topic
+	PartitionKey string
+}
+
+func (a KafkaAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
+
+// NATSAddress is a NATS specific struct, which publishes the notification to the Subject of the NATS servers
+type NATSAddress struct {
+	BaseAddress
+	Security
+	// Brokers are the URLs of the NATS servers, e.g. nats://localhost:4222
+	Brokers []string
+	Subject string
+	// Headers are the custom headers added to the published messages
+	Headers map[string]string
+	// JetStream indicates whether to publish to a JetStream stream and wait for the acknowledgement
+	JetStream bool
+}
+
+func (a NATSAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
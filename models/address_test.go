@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalAddress_BrokerAddresses(t *testing.T) {
+	kafka := KafkaAddress{
+		BaseAddress:  BaseAddress{Type: common.KAFKA, MinSeverity: Normal},
+		MessageBus:   MessageBus{Topic: "edgex-notifications"},
+		Security:     Security{SecretPath: "kafka", AuthMode: "clientcert"},
+		Brokers:      []string{"localhost:9092", "localhost:9093"},
+		Headers:      map[string]string{"source": "edgex"},
+		ClientId:     "edgex",
+		PartitionKey: "notifications",
+	}
+	nats := NATSAddress{
+		BaseAddress: BaseAddress{Type: common.NATS},
+		Security:    Security{SecretPath: "nats", AuthMode: "usernamepassword", SkipCertVerify: true},
+		Brokers:     []string{"nats://localhost:4222"},
+		Subject:     "edgex.notifications",
+		Headers:     map[string]string{"source": "edgex"},
+		JetStream:   true,
+	}
+
+	tests := []struct {
+		name    string
+		address Address
+	}{
+		{"KAFKA address", kafka},
+		{"NATS address", nats},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.address)
+			require.NoError(t, err)
+			result, err := unmarshalAddress(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.address, result)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// EscalationPolicy escalates the unacknowledged notifications of a Subscription level by level
+type EscalationPolicy struct {
+	Levels []EscalationLevel
+}
+
+// EscalationLevel escalates to the subscription named SubscriptionName or to the Channels when the transmission
+// fails AfterFailures times, or isn't acknowledged within the AckTimeout, since the previous level is escalated or
+// since the transmission is created for the first level.
+type EscalationLevel struct {
+	AfterFailures    int
+	AckTimeout       string
+	SubscriptionName string
+	Channels         []Address
+}
+
+func (level *EscalationLevel) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		AfterFailures    int
+		AckTimeout       string
+		SubscriptionName string
+		Channels         []interface{}
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal escalation level.", err)
+	}
+	var channels []Address
+	if alias.Channels != nil {
+		channels = make([]Address, len(alias.Channels))
+		for i, c := range alias.Channels {
+			address, err := instantiateAddress(c)
+			if err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			channels[i] = address
+		}
+	}
+
+	*level = EscalationLevel{
+		AfterFailures:    alias.AfterFailures,
+		AckTimeout:       alias.AckTimeout,
+		SubscriptionName: alias.SubscriptionName,
+		Channels:         channels,
+	}
+	return nil
+}
+
+// EscalationAction is the next step of the escalation decided by NextEscalationStep
+type EscalationAction string
+
+const (
+	// EscalationActionNone doesn't escalate anymore because the transmission is acknowledged or all the levels are
+	// escalated
+	EscalationActionNone EscalationAction = "NONE"
+	// EscalationActionWait waits for the next failure or the ack timeout of the next level
+	EscalationActionWait EscalationAction = "WAIT"
+	// EscalationActionEscalate escalates to the next level
+	EscalationActionEscalate EscalationAction = "ESCALATE"
+)
+
+// EscalationStep is the next step of the escalation of a transmission
+type EscalationStep struct {
+	Action EscalationAction
+	// Level is the index of the next level in the policy, which is meaningful unless the Action is NONE
+	Level int
+	// Deadline is the time at which the next level escalates because of the ack timeout, or the zero time if the
+	// next level has no ack timeout
+	Deadline time.Time
+}
+
+// NextEscalationStep decides the next escalation step of the transmission from its records. Each escalated level is
+// recorded as a TransmissionRecord with the ESCALATED status, so the number of such records is the number of the
+// escalated levels, and the failures and the ack timeout of the next level count from the latest of such records.
+// The transmission is considered acknowledged if its status or any of its records is ACKNOWLEDGED.
+func NextEscalationStep(policy EscalationPolicy, trans Transmission, now time.Time) (EscalationStep, errors.EdgeX) {
+	if trans.Status == Acknowledged {
+		return EscalationStep{Action: EscalationActionNone}, nil
+	}
+	escalated := 0
+	since := trans.Created
+	for _, record := range trans.Records {
+		switch record.Status {
+		case Acknowledged:
+			return EscalationStep{Action: EscalationActionNone}, nil
+		case Escalated:
+			escalated++
+			since = max(since, record.Sent)
+		}
+	}
+	if escalated >= len(policy.Levels) {
+		return EscalationStep{Action: EscalationActionNone}, nil
+	}
+
+	level := policy.Levels[escalated]
+	step := EscalationStep{Action: EscalationActionWait, Level: escalated}
+	if level.AckTimeout != "" {
+		valid, timeout := common.ParseDurationWithDay(level.AckTimeout)
+		if !valid || timeout <= 0 {
+			return EscalationStep{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid ack timeout '%s' of the escalation level %d", level.AckTimeout, escalated), nil)
+		}
+		step.Deadline = time.UnixMilli(since).Add(timeout).In(now.Location())
+		if !now.Before(step.Deadline) {
+			step.Action = EscalationActionEscalate
+		}
+	}
+	if level.AfterFailures > 0 {
+		failures := 0
+		for _, record := range trans.Records {
+			if record.Status == Failed && record.Sent >= since {
+				failures++
+			}
+		}
+		if failures >= level.AfterFailures {
+			step.Action = EscalationActionEscalate
+		}
+	}
+	return step, nil
+}
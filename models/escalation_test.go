@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func escalationPolicyData() EscalationPolicy {
+	return EscalationPolicy{
+		Levels: []EscalationLevel{
+			{AfterFailures: 3, AckTimeout: "10m", SubscriptionName: "on-call"},
+			{AckTimeout: "30m", Channels: []Address{
+				EmailAddress{BaseAddress: BaseAddress{Type: common.EMAIL}, Recipients: []string{"manager@example.com"}},
+			}},
+		},
+	}
+}
+
+func TestNextEscalationStep(t *testing.T) {
+	created := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return created.Add(d).UnixMilli() }
+	policy := escalationPolicyData()
+	failuresOnly := EscalationPolicy{Levels: []EscalationLevel{{AfterFailures: 2, SubscriptionName: "on-call"}}}
+	invalidTimeout := EscalationPolicy{Levels: []EscalationLevel{{AckTimeout: "soon", SubscriptionName: "on-call"}}}
+
+	transmission := func(status TransmissionStatus, records ...TransmissionRecord) Transmission {
+		return Transmission{Created: created.UnixMilli(), Status: status, Records: records}
+	}
+	failed := func(d time.Duration) TransmissionRecord { return TransmissionRecord{Status: Failed, Sent: at(d)} }
+	escalated := func(d time.Duration) TransmissionRecord { return TransmissionRecord{Status: Escalated, Sent: at(d)} }
+
+	tests := []struct {
+		name     string
+		policy   EscalationPolicy
+		trans    Transmission
+		now      time.Time
+		expected EscalationStep
+	}{
+		{"wait for the first level ack timeout", policy, transmission(Sent, TransmissionRecord{Status: Sent, Sent: at(0)}),
+			created.Add(5 * time.Minute), EscalationStep{Action: EscalationActionWait, Level: 0, Deadline: created.Add(10 * time.Minute)}},
+		{"escalate the first level on ack timeout", policy, transmission(Sent, TransmissionRecord{Status: Sent, Sent: at(0)}),
+			created.Add(10 * time.Minute), EscalationStep{Action: EscalationActionEscalate, Level: 0, Deadline: created.Add(10 * time.Minute)}},
+		{"escalate the first level on failures", policy, transmission(RESENDING, failed(0), failed(time.Minute), failed(2*time.Minute)),
+			created.Add(3 * time.Minute), EscalationStep{Action: EscalationActionEscalate, Level: 0, Deadline: created.Add(10 * time.Minute)}},
+		{"wait for the second level ack timeout counted from the escalation", policy,
+			transmission(Escalated, failed(0), escalated(20*time.Minute), failed(25*time.Minute)),
+			created.Add(40 * time.Minute), EscalationStep{Action: EscalationActionWait, Level: 1, Deadline: created.Add(50 * time.Minute)}},
+		{"escalate the second level on ack timeout", policy, transmission(Escalated, escalated(20*time.Minute)),
+			created.Add(50 * time.Minute), EscalationStep{Action: EscalationActionEscalate, Level: 1, Deadline: created.Add(50 * time.Minute)}},
+		{"all levels escalated", policy, transmission(Escalated, escalated(10*time.Minute), escalated(40*time.Minute)),
+			created.Add(24 * time.Hour), EscalationStep{Action: EscalationActionNone}},
+		{"acknowledged transmission", policy, transmission(Acknowledged, failed(0), failed(time.Minute), failed(2*time.Minute)),
+			created.Add(time.Hour), EscalationStep{Action: EscalationActionNone}},
+		{"acknowledged record", policy, transmission(Sent, TransmissionRecord{Status: Acknowledged, Sent: at(time.Minute)}),
+			created.Add(time.Hour), EscalationStep{Action: EscalationActionNone}},
+		{"wait for more failures without ack timeout", failuresOnly, transmission(Failed, failed(0)),
+			created.Add(time.Hour), EscalationStep{Action: EscalationActionWait, Level: 0}},
+		{"failures before the escalation are not counted", failuresOnly,
+			transmission(Escalated, failed(0), failed(time.Minute), escalated(2*time.Minute), failed(3*time.Minute)),
+			created.Add(time.Hour), EscalationStep{Action: EscalationActionNone}},
+		{"no levels", EscalationPolicy{}, transmission(Failed, failed(0)), created, EscalationStep{Action: EscalationActionNone}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, err := NextEscalationStep(tt.policy, tt.trans, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.Action, step.Action)
+			assert.Equal(t, tt.expected.Level, step.Level)
+			assert.True(t, tt.expected.Deadline.Equal(step.Deadline), "expected %s, got %s", tt.expected.Deadline, step.Deadline)
+		})
+	}
+
+	_, err := NextEscalationStep(invalidTimeout, transmission(Sent), created)
+	assert.Error(t, err)
+}
+
+func TestEscalationLevel_UnmarshalJSON(t *testing.T) {
+	valid := subscriptionData()
+	policy := escalationPolicyData()
+	valid.EscalationPolicy = &policy
+	jsonData, err := json.Marshal(valid)
+	require.NoError(t, err)
+
+	var result Subscription
+	err = json.Unmarshal(jsonData, &result)
+	require.NoError(t, err)
+	assert.Equal(t, valid, result)
+
+	var level EscalationLevel
+	err = json.Unmarshal([]byte(`{"SubscriptionName":"on-call","Channels":[{"Type":"unknown"}]}`), &level)
+	assert.Error(t, err)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -17,6 +17,9 @@ type Notification struct {
 	Severity     NotificationSeverity
 	Status       NotificationStatus
 	Acknowledged bool
+	// DedupKey identifies the repeated notifications, which are suppressed within the SuppressionWindow
+	DedupKey          string
+	SuppressionWindow string
 }
 
 // NotificationSeverity indicates the level of severity for the notification.
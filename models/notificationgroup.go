@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// NotificationGroupKey returns the key of the group which the notification belongs to under the grouping keys of a
+// Subscription. The notifications with the same values of the grouping keys have the same group key regardless of
+// the order of the grouping keys and labels. Without grouping keys, the notifications are grouped by the DedupKey,
+// and the empty string is returned if the notification has no DedupKey, which means the notification isn't grouped.
+// The unknown grouping keys are ignored.
+func NotificationGroupKey(n Notification, groupingKeys []string) string {
+	if len(groupingKeys) == 0 {
+		if n.DedupKey == "" {
+			return ""
+		}
+		groupingKeys = []string{common.GroupingKeyDedupKey}
+	}
+
+	values := make(map[string]any, len(groupingKeys))
+	for _, key := range groupingKeys {
+		switch key {
+		case common.GroupingKeyCategory:
+			values[key] = n.Category
+		case common.GroupingKeyLabels:
+			// the nil and empty labels are both encoded as an empty array to be in the same group
+			labels := append([]string{}, n.Labels...)
+			slices.Sort(labels)
+			values[key] = slices.Compact(labels)
+		case common.GroupingKeySender:
+			values[key] = n.Sender
+		case common.GroupingKeySeverity:
+			values[key] = n.Severity
+		case common.GroupingKeyContent:
+			values[key] = n.Content
+		case common.GroupingKeyDedupKey:
+			values[key] = n.DedupKey
+		}
+	}
+	// the map keys are sorted by json.Marshal, so the encoding is canonical, and the marshaling never fails
+	b, _ := json.Marshal(values)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationGroupKey(t *testing.T) {
+	notification := Notification{
+		Category: "health-check",
+		Labels:   []string{"offline", "device"},
+		Content:  "pump-1 is offline",
+		Sender:   "core-metadata",
+		Severity: Critical,
+		DedupKey: "pump-1-offline",
+	}
+	repeated := notification
+	repeated.Id = ExampleUUID
+	repeated.Labels = []string{"device", "offline", "device"}
+	repeated.Content = "pump-1 is still offline"
+	otherSender := notification
+	otherSender.Sender = "device-modbus"
+	otherDedupKey := notification
+	otherDedupKey.DedupKey = "pump-2-offline"
+	noDedupKey := notification
+	noDedupKey.DedupKey = ""
+	nilLabels := notification
+	nilLabels.Labels = nil
+	emptyLabels := notification
+	emptyLabels.Labels = []string{}
+
+	groupingKeys := []string{common.GroupingKeyCategory, common.GroupingKeyLabels, common.GroupingKeySender}
+	key := NotificationGroupKey(notification, groupingKeys)
+	assert.Len(t, key, 64)
+
+	tests := []struct {
+		name         string
+		first        Notification
+		second       Notification
+		groupingKeys []string
+		sameGroup    bool
+	}{
+		{"same values of grouping keys", notification, repeated, groupingKeys, true},
+		{"reordered grouping keys", notification, notification,
+			[]string{common.GroupingKeySender, common.GroupingKeyCategory, common.GroupingKeyLabels}, true},
+		{"different sender", notification, otherSender, groupingKeys, false},
+		{"different content", notification, repeated, []string{common.GroupingKeyContent}, false},
+		{"same dedup key without grouping keys", notification, repeated, nil, true},
+		{"different dedup key without grouping keys", notification, otherDedupKey, nil, false},
+		{"different dedup key ignored by grouping keys", notification, otherDedupKey, []string{common.GroupingKeySeverity}, true},
+		{"nil and empty labels", nilLabels, emptyLabels, groupingKeys, true},
+		{"nil and non-empty labels", nilLabels, notification, groupingKeys, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := NotificationGroupKey(tt.first, tt.groupingKeys)
+			second := NotificationGroupKey(tt.second, tt.groupingKeys)
+			assert.NotEmpty(t, first)
+			assert.Equal(t, tt.sameGroup, first == second)
+		})
+	}
+
+	assert.Empty(t, NotificationGroupKey(noDedupKey, nil), "notification without DedupKey and grouping keys should not be grouped")
+	assert.NotEqual(t, NotificationGroupKey(notification, []string{common.GroupingKeyCategory}),
+		NotificationGroupKey(notification, []string{common.GroupingKeySender}), "different grouping keys should result in different groups")
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// NotificationTemplate renders the notification content per channel with the text/template syntax. The Content
+// variant applies to every channel without its own variant. The template is referred to by name from the Subscription.
+type NotificationTemplate struct {
+	DBTimestamp
+	Id           string
+	Name         string
+	Description  string
+	Content      string
+	EmailSubject string
+	EmailBody    string
+	MQTTPayload  string
+}
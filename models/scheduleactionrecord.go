@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -18,6 +18,10 @@ type ScheduleActionRecord struct {
 	Status      ScheduleActionRecordStatus
 	ScheduledAt int64
 	Created     int64
+	// AttemptCount is the number of attempts made to execute the action, including the retries
+	AttemptCount int
+	// LastError is the error message of the last failed attempt
+	LastError string
 }
 
 // ScheduleActionRecordStatus indicates the most recent success/failure of a given schedule action attempt or a missed record.
@@ -25,12 +29,14 @@ type ScheduleActionRecordStatus string
 
 func (scheduleActionRecord *ScheduleActionRecord) UnmarshalJSON(b []byte) error {
 	var alias struct {
-		Id          string
-		JobName     string
-		Action      any
-		Status      ScheduleActionRecordStatus
-		ScheduledAt int64
-		Created     int64
+		Id           string
+		JobName      string
+		Action       any
+		Status       ScheduleActionRecordStatus
+		ScheduledAt  int64
+		Created      int64
+		AttemptCount int
+		LastError    string
 	}
 
 	if err := json.Unmarshal(b, &alias); err != nil {
@@ -43,12 +49,14 @@ func (scheduleActionRecord *ScheduleActionRecord) UnmarshalJSON(b []byte) error
 	}
 
 	*scheduleActionRecord = ScheduleActionRecord{
-		Id:          alias.Id,
-		JobName:     alias.JobName,
-		Action:      action,
-		Status:      alias.Status,
-		ScheduledAt: alias.ScheduledAt,
-		Created:     alias.Created,
+		Id:           alias.Id,
+		JobName:      alias.JobName,
+		Action:       action,
+		Status:       alias.Status,
+		ScheduledAt:  alias.ScheduledAt,
+		Created:      alias.Created,
+		AttemptCount: alias.AttemptCount,
+		LastError:    alias.LastError,
 	}
 	return nil
 }
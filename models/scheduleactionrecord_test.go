@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -22,10 +22,17 @@ func scheduleActionRecordWithEDGEXMESSAGEBUSScheduleAction() ScheduleActionRecor
 }
 
 func scheduleActionRecordWithRESTScheduleAction() ScheduleActionRecord {
+	action := restAction
+	action.RetryCount = 3
+	action.RetryBackoff = "5s"
+	action.Timeout = "30s"
 	return ScheduleActionRecord{
-		Id:      ExampleUUID,
-		JobName: TestScheduleJobName,
-		Action:  restAction,
+		Id:           ExampleUUID,
+		JobName:      TestScheduleJobName,
+		Action:       action,
+		Status:       Failed,
+		AttemptCount: 4,
+		LastError:    "connection refused",
 	}
 }
 
@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/cron"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// maxFireTimeSearchYears bounds the search of the next fire time when the schedule never fires within the
+// ActiveYearlyTimeWindow, e.g. a yearly cron on Jan 1 with a window from Jun 1 to Jun 30
+const maxFireTimeSearchYears = 5
+
+// maxNextFireTimes is the maximum number of fire times computed by NextFireTimes at a time
+const maxNextFireTimes = 10000
+
+// fireTimesInitialCapacity bounds the memory preallocated for the fire times, since a schedule may fire far fewer
+// times than requested, e.g. a ONCE schedule
+const fireTimesInitialCapacity = 64
+
+// calendarDateLayout is the layout of the CalendarScheduleDef.ExcludedDates
+const calendarDateLayout = "2006-01-02"
+
+// NextFireTimes returns at most n fire times of the schedule definition strictly after from, in the location of from,
+// and n must not exceed 10000. The StartTimestamp and EndTimestamp, in milliseconds, bound the fire times inclusively, and the fire times outside
+// the ActiveYearlyTimeWindow are skipped.
+//
+// An INTERVAL schedule fires at StartTimestamp and then every interval, or every interval after from if there is
+// no StartTimestamp. A CRON schedule fires at the times matching the crontab. A ONCE schedule fires at FireTimestamp,
+// and a CALENDAR schedule fires at FireTimestamps except those on the ExcludedDates.
+//
+// The ActiveYearlyTimeWindow, the crontab and the ExcludedDates are evaluated in the TimeZone of the definition, or
+// in the location of from if there is no TimeZone. A crontab with the CRON_TZ or TZ prefix uses its own time zone.
+func NextFireTimes(def ScheduleDef, from time.Time, n int) ([]time.Time, errors.EdgeX) {
+	if def == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "schedule definition must not be nil", nil)
+	}
+	if n > maxNextFireTimes {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the number of fire times %d exceeds the maximum of %d", n, maxNextFireTimes), nil)
+	}
+	base := def.GetBaseScheduleDef()
+	resultLocation := from.Location()
+	if base.TimeZone != "" {
+		zone, err := common.LoadTimeZone(base.TimeZone)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		from = from.In(zone)
+	}
+	location := from.Location()
+
+	var start, end time.Time
+	if base.StartTimestamp > 0 {
+		start = time.UnixMilli(base.StartTimestamp).In(location)
+	}
+	if base.EndTimestamp > 0 {
+		end = time.UnixMilli(base.EndTimestamp).In(location)
+	}
+
+	next, err := nextFireFunc(def, from, start)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	fireTimes := make([]time.Time, 0, min(max(n, 0), fireTimesInitialCapacity))
+	t := from
+	if !start.IsZero() && t.Before(start) {
+		// the schedule may fire exactly at the start time
+		t = start.Add(-time.Nanosecond)
+	}
+	searchLimit := t.AddDate(maxFireTimeSearchYears, 0, 0)
+	for len(fireTimes) < n {
+		t = next(t)
+		if t.IsZero() || (!end.IsZero() && t.After(end)) {
+			break
+		}
+		if w := base.ActiveYearlyTimeWindow; w != nil && !w.Contains(t) {
+			if t.After(searchLimit) {
+				break
+			}
+			// jump to the next window instead of evaluating every tick outside the window
+			t = w.nextStart(t).Add(-time.Nanosecond)
+			continue
+		}
+		fireTimes = append(fireTimes, t.In(resultLocation))
+		searchLimit = t.AddDate(maxFireTimeSearchYears, 0, 0)
+	}
+	return fireTimes, nil
+}
+
+// nextFireFunc returns the function which computes the first fire time strictly after the specified time
+func nextFireFunc(def ScheduleDef, from, start time.Time) (func(time.Time) time.Time, errors.EdgeX) {
+	switch d := def.(type) {
+	case IntervalScheduleDef:
+		valid, interval := common.ParseDurationWithDay(d.Interval)
+		if !valid || interval <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid interval '%s'", d.Interval), nil)
+		}
+		anchor := from
+		if !start.IsZero() {
+			anchor = start
+		}
+		return func(t time.Time) time.Time {
+			elapsed := t.Sub(anchor)
+			k := elapsed / interval
+			if elapsed < 0 && elapsed%interval != 0 {
+				// round towards negative infinity
+				k--
+			}
+			return anchor.Add((k + 1) * interval)
+		}, nil
+	case CronScheduleDef:
+		schedule, err := cron.Parse(d.Crontab)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		return schedule.Next, nil
+	case OnceScheduleDef:
+		if d.FireTimestamp <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid fire timestamp %d", d.FireTimestamp), nil)
+		}
+		fireTime := time.UnixMilli(d.FireTimestamp)
+		return func(t time.Time) time.Time {
+			if fireTime.After(t) {
+				return fireTime.In(t.Location())
+			}
+			return time.Time{}
+		}, nil
+	case CalendarScheduleDef:
+		excludedDates := make(map[string]struct{}, len(d.ExcludedDates))
+		for _, date := range d.ExcludedDates {
+			if _, err := time.Parse(calendarDateLayout, date); err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid excluded date '%s'", date), err)
+			}
+			excludedDates[date] = struct{}{}
+		}
+		fireTimestamps := slices.Clone(d.FireTimestamps)
+		slices.Sort(fireTimestamps)
+		return func(t time.Time) time.Time {
+			i, _ := slices.BinarySearch(fireTimestamps, t.UnixMilli()+1)
+			for ; i < len(fireTimestamps); i++ {
+				fireTime := time.UnixMilli(fireTimestamps[i]).In(t.Location())
+				if !fireTime.After(t) {
+					continue
+				}
+				if _, excluded := excludedDates[fireTime.Format(calendarDateLayout)]; !excluded {
+					return fireTime
+				}
+			}
+			return time.Time{}
+		}, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("unsupported schedule definition type '%s'", def.GetBaseScheduleDef().Type), nil)
+	}
+}
+
+// Contains checks whether the date of t, in the location of t, is within the window. The end day is inclusive, and
+// the window crosses the year if the start date is after the end date.
+func (w ActiveYearlyTimeWindow) Contains(t time.Time) bool {
+	date := monthDay(int(t.Month()), t.Day())
+	start := monthDay(w.StartMonth, w.StartDay)
+	end := monthDay(w.EndMonth, w.EndDay)
+	if start <= end {
+		return start <= date && date <= end
+	}
+	return date >= start || date <= end
+}
+
+// nextStart returns the beginning of the first window start day after t in the location of t. A window starting on
+// Feb 29 starts on Mar 1 in a non-leap year, which is the first day after Feb 28 within the window.
+func (w ActiveYearlyTimeWindow) nextStart(t time.Time) time.Time {
+	candidate := time.Date(t.Year(), time.Month(w.StartMonth), w.StartDay, 0, 0, 0, 0, t.Location())
+	if !candidate.After(t) {
+		candidate = time.Date(t.Year()+1, time.Month(w.StartMonth), w.StartDay, 0, 0, 0, 0, t.Location())
+	}
+	return candidate
+}
+
+// monthDay encodes the month and day into a comparable number
+func monthDay(month, day int) int {
+	return month*100 + day
+}
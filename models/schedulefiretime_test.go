@@ -0,0 +1,372 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+func utcTime(year int, month time.Month, day, hour, minute, second int) time.Time {
+	return time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+}
+
+func intervalDef(interval string, start, end time.Time, window *ActiveYearlyTimeWindow) IntervalScheduleDef {
+	return IntervalScheduleDef{
+		BaseScheduleDef: BaseScheduleDef{
+			Type:                   common.DefInterval,
+			StartTimestamp:         unixMilli(start),
+			EndTimestamp:           unixMilli(end),
+			ActiveYearlyTimeWindow: window,
+		},
+		Interval: interval,
+	}
+}
+
+func cronDef(crontab string, start, end time.Time, window *ActiveYearlyTimeWindow) CronScheduleDef {
+	return CronScheduleDef{
+		BaseScheduleDef: BaseScheduleDef{
+			Type:                   common.DefCron,
+			StartTimestamp:         unixMilli(start),
+			EndTimestamp:           unixMilli(end),
+			ActiveYearlyTimeWindow: window,
+		},
+		Crontab: crontab,
+	}
+}
+
+func onceDef(fireTime, start, end time.Time, window *ActiveYearlyTimeWindow) OnceScheduleDef {
+	return OnceScheduleDef{
+		BaseScheduleDef: BaseScheduleDef{
+			Type:                   common.DefOnce,
+			StartTimestamp:         unixMilli(start),
+			EndTimestamp:           unixMilli(end),
+			ActiveYearlyTimeWindow: window,
+		},
+		FireTimestamp: fireTime.UnixMilli(),
+	}
+}
+
+func calendarDef(fireTimes []time.Time, excludedDates []string, start, end time.Time, window *ActiveYearlyTimeWindow) CalendarScheduleDef {
+	fireTimestamps := make([]int64, len(fireTimes))
+	for i, t := range fireTimes {
+		fireTimestamps[i] = t.UnixMilli()
+	}
+	return CalendarScheduleDef{
+		BaseScheduleDef: BaseScheduleDef{
+			Type:                   common.DefCalendar,
+			StartTimestamp:         unixMilli(start),
+			EndTimestamp:           unixMilli(end),
+			ActiveYearlyTimeWindow: window,
+		},
+		FireTimestamps: fireTimestamps,
+		ExcludedDates:  excludedDates,
+	}
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func TestNextFireTimes(t *testing.T) {
+	from := utcTime(2026, time.March, 14, 10, 17, 42)
+	none := time.Time{}
+	summer := &ActiveYearlyTimeWindow{StartMonth: 6, StartDay: 1, EndMonth: 8, EndDay: 31}
+	winter := &ActiveYearlyTimeWindow{StartMonth: 11, StartDay: 15, EndMonth: 2, EndDay: 10}
+	singleDay := &ActiveYearlyTimeWindow{StartMonth: 3, StartDay: 15, EndMonth: 3, EndDay: 15}
+	sameMonthCrossing := &ActiveYearlyTimeWindow{StartMonth: 3, StartDay: 20, EndMonth: 3, EndDay: 10}
+	leapDay := &ActiveYearlyTimeWindow{StartMonth: 2, StartDay: 29, EndMonth: 3, EndDay: 1}
+
+	tests := []struct {
+		name     string
+		def      ScheduleDef
+		n        int
+		expected []time.Time
+	}{
+		// INTERVAL
+		{"interval without start", intervalDef("10m", none, none, nil), 3, []time.Time{
+			utcTime(2026, time.March, 14, 10, 27, 42), utcTime(2026, time.March, 14, 10, 37, 42), utcTime(2026, time.March, 14, 10, 47, 42)}},
+		{"interval with days", intervalDef("1d12h", none, none, nil), 2, []time.Time{
+			utcTime(2026, time.March, 15, 22, 17, 42), utcTime(2026, time.March, 17, 10, 17, 42)}},
+		{"interval with future start fires at start", intervalDef("1h", utcTime(2026, time.March, 20, 0, 0, 0), none, nil), 3, []time.Time{
+			utcTime(2026, time.March, 20, 0, 0, 0), utcTime(2026, time.March, 20, 1, 0, 0), utcTime(2026, time.March, 20, 2, 0, 0)}},
+		{"interval with past start aligns to start", intervalDef("1h", utcTime(2026, time.March, 1, 0, 30, 0), none, nil), 2, []time.Time{
+			utcTime(2026, time.March, 14, 10, 30, 0), utcTime(2026, time.March, 14, 11, 30, 0)}},
+		{"interval with start equal to from", intervalDef("1h", from, none, nil), 1, []time.Time{
+			utcTime(2026, time.March, 14, 11, 17, 42)}},
+		{"interval with end", intervalDef("1h", utcTime(2026, time.March, 14, 11, 0, 0), utcTime(2026, time.March, 14, 13, 0, 0), nil), 5, []time.Time{
+			utcTime(2026, time.March, 14, 11, 0, 0), utcTime(2026, time.March, 14, 12, 0, 0), utcTime(2026, time.March, 14, 13, 0, 0)}},
+		{"interval with past end", intervalDef("1h", none, utcTime(2026, time.March, 1, 0, 0, 0), nil), 5, []time.Time{}},
+		{"interval within window", intervalDef("1d", utcTime(2026, time.January, 1, 6, 0, 0), none, summer), 3, []time.Time{
+			utcTime(2026, time.June, 1, 6, 0, 0), utcTime(2026, time.June, 2, 6, 0, 0), utcTime(2026, time.June, 3, 6, 0, 0)}},
+		{"interval leaves window at end day", intervalDef("1d", utcTime(2026, time.August, 30, 6, 0, 0), none, summer), 3, []time.Time{
+			utcTime(2026, time.August, 30, 6, 0, 0), utcTime(2026, time.August, 31, 6, 0, 0), utcTime(2027, time.June, 1, 6, 0, 0)}},
+		{"interval year-crossing window", intervalDef("7d", utcTime(2026, time.January, 1, 0, 0, 0), none, winter), 4, []time.Time{
+			utcTime(2026, time.November, 19, 0, 0, 0), utcTime(2026, time.November, 26, 0, 0, 0),
+			utcTime(2026, time.December, 3, 0, 0, 0), utcTime(2026, time.December, 10, 0, 0, 0)}},
+		{"interval year-crossing window wraps into next year", intervalDef("30d", utcTime(2026, time.November, 15, 0, 0, 0), none, winter), 4, []time.Time{
+			utcTime(2026, time.November, 15, 0, 0, 0), utcTime(2026, time.December, 15, 0, 0, 0),
+			utcTime(2027, time.January, 14, 0, 0, 0), utcTime(2027, time.December, 10, 0, 0, 0)}},
+		{"interval single-day window", intervalDef("8h", utcTime(2026, time.March, 1, 0, 0, 0), none, singleDay), 4, []time.Time{
+			utcTime(2026, time.March, 15, 0, 0, 0), utcTime(2026, time.March, 15, 8, 0, 0),
+			utcTime(2026, time.March, 15, 16, 0, 0), utcTime(2027, time.March, 15, 0, 0, 0)}},
+		{"interval window and end", intervalDef("12h", utcTime(2026, time.January, 1, 0, 0, 0), utcTime(2026, time.June, 1, 12, 0, 0), summer), 5, []time.Time{
+			utcTime(2026, time.June, 1, 0, 0, 0), utcTime(2026, time.June, 1, 12, 0, 0)}},
+
+		// CRON
+		{"cron every 5 minutes", cronDef("*/5 * * * *", none, none, nil), 3, []time.Time{
+			utcTime(2026, time.March, 14, 10, 20, 0), utcTime(2026, time.March, 14, 10, 25, 0), utcTime(2026, time.March, 14, 10, 30, 0)}},
+		{"cron with seconds", cronDef("*/20 * * * * *", none, none, nil), 3, []time.Time{
+			utcTime(2026, time.March, 14, 10, 18, 0), utcTime(2026, time.March, 14, 10, 18, 20), utcTime(2026, time.March, 14, 10, 18, 40)}},
+		{"cron with future start", cronDef("@daily", utcTime(2026, time.April, 1, 0, 0, 0), none, nil), 2, []time.Time{
+			utcTime(2026, time.April, 1, 0, 0, 0), utcTime(2026, time.April, 2, 0, 0, 0)}},
+		{"cron with past start", cronDef("@daily", utcTime(2026, time.January, 1, 0, 0, 0), none, nil), 1, []time.Time{
+			utcTime(2026, time.March, 15, 0, 0, 0)}},
+		{"cron with end", cronDef("0 12 * * *", none, utcTime(2026, time.March, 16, 12, 0, 0), nil), 5, []time.Time{
+			utcTime(2026, time.March, 14, 12, 0, 0), utcTime(2026, time.March, 15, 12, 0, 0), utcTime(2026, time.March, 16, 12, 0, 0)}},
+		{"cron within window", cronDef("0 9 1 * *", none, none, summer), 4, []time.Time{
+			utcTime(2026, time.June, 1, 9, 0, 0), utcTime(2026, time.July, 1, 9, 0, 0),
+			utcTime(2026, time.August, 1, 9, 0, 0), utcTime(2027, time.June, 1, 9, 0, 0)}},
+		{"cron year-crossing window", cronDef("0 0 1 * *", none, none, winter), 4, []time.Time{
+			utcTime(2026, time.December, 1, 0, 0, 0), utcTime(2027, time.January, 1, 0, 0, 0),
+			utcTime(2027, time.February, 1, 0, 0, 0), utcTime(2027, time.December, 1, 0, 0, 0)}},
+		{"cron inside year-crossing window at from", cronDef("0 0 * * MON", none, none, &ActiveYearlyTimeWindow{StartMonth: 12, StartDay: 1, EndMonth: 3, EndDay: 20}), 2, []time.Time{
+			utcTime(2026, time.March, 16, 0, 0, 0), utcTime(2026, time.December, 7, 0, 0, 0)}},
+		{"cron same-month year-crossing window", cronDef("0 0 * * *", none, none, sameMonthCrossing), 3, []time.Time{
+			utcTime(2026, time.March, 20, 0, 0, 0), utcTime(2026, time.March, 21, 0, 0, 0), utcTime(2026, time.March, 22, 0, 0, 0)}},
+		{"cron leap day window", cronDef("0 0 * * *", none, none, leapDay), 4, []time.Time{
+			utcTime(2027, time.March, 1, 0, 0, 0), utcTime(2028, time.February, 29, 0, 0, 0),
+			utcTime(2028, time.March, 1, 0, 0, 0), utcTime(2029, time.March, 1, 0, 0, 0)}},
+		{"cron never within window", cronDef("@yearly", none, none, summer), 3, []time.Time{}},
+		{"cron never fires", cronDef("0 0 30 2 *", none, none, nil), 3, []time.Time{}},
+		{"zero n", cronDef("@daily", none, none, nil), 0, []time.Time{}},
+
+		// ONCE
+		{"once", onceDef(utcTime(2026, time.April, 1, 8, 0, 0), none, none, nil), 3, []time.Time{utcTime(2026, time.April, 1, 8, 0, 0)}},
+		{"once in the past", onceDef(utcTime(2026, time.March, 1, 8, 0, 0), none, none, nil), 3, []time.Time{}},
+		{"once at from", onceDef(from, none, none, nil), 1, []time.Time{}},
+		{"once far in the future", onceDef(utcTime(2040, time.April, 1, 8, 0, 0), none, none, nil), 1, []time.Time{utcTime(2040, time.April, 1, 8, 0, 0)}},
+		{"once before start", onceDef(utcTime(2026, time.April, 1, 8, 0, 0), utcTime(2026, time.May, 1, 0, 0, 0), none, nil), 1, []time.Time{}},
+		{"once after end", onceDef(utcTime(2026, time.April, 1, 8, 0, 0), none, utcTime(2026, time.March, 31, 0, 0, 0), nil), 1, []time.Time{}},
+		{"once outside window", onceDef(utcTime(2026, time.April, 1, 8, 0, 0), none, none, summer), 1, []time.Time{}},
+
+		// CALENDAR
+		{"calendar", calendarDef([]time.Time{utcTime(2026, time.May, 1, 8, 0, 0), utcTime(2026, time.March, 20, 8, 0, 0), utcTime(2026, time.March, 1, 8, 0, 0)}, nil, none, none, nil), 5,
+			[]time.Time{utcTime(2026, time.March, 20, 8, 0, 0), utcTime(2026, time.May, 1, 8, 0, 0)}},
+		{"calendar with excluded dates", calendarDef([]time.Time{utcTime(2026, time.December, 24, 8, 0, 0), utcTime(2026, time.December, 25, 8, 0, 0), utcTime(2026, time.December, 26, 8, 0, 0)},
+			[]string{"2026-12-25"}, none, none, nil), 5, []time.Time{utcTime(2026, time.December, 24, 8, 0, 0), utcTime(2026, time.December, 26, 8, 0, 0)}},
+		{"calendar with n", calendarDef([]time.Time{utcTime(2026, time.April, 1, 8, 0, 0), utcTime(2026, time.April, 2, 8, 0, 0), utcTime(2026, time.April, 3, 8, 0, 0)}, nil, none, none, nil), 2,
+			[]time.Time{utcTime(2026, time.April, 1, 8, 0, 0), utcTime(2026, time.April, 2, 8, 0, 0)}},
+		{"calendar with start and end", calendarDef([]time.Time{utcTime(2026, time.April, 1, 8, 0, 0), utcTime(2026, time.April, 2, 8, 0, 0), utcTime(2026, time.April, 3, 8, 0, 0)}, nil,
+			utcTime(2026, time.April, 2, 0, 0, 0), utcTime(2026, time.April, 2, 8, 0, 0), nil), 5, []time.Time{utcTime(2026, time.April, 2, 8, 0, 0)}},
+		{"calendar with window", calendarDef([]time.Time{utcTime(2026, time.May, 31, 8, 0, 0), utcTime(2026, time.June, 1, 8, 0, 0), utcTime(2026, time.September, 1, 8, 0, 0)}, nil, none, none, summer), 5,
+			[]time.Time{utcTime(2026, time.June, 1, 8, 0, 0)}},
+		{"calendar with duplicates", calendarDef([]time.Time{utcTime(2026, time.April, 1, 8, 0, 0), utcTime(2026, time.April, 1, 8, 0, 0)}, nil, none, none, nil), 5,
+			[]time.Time{utcTime(2026, time.April, 1, 8, 0, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NextFireTimes(tt.def, from, tt.n)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNextFireTimes_Location(t *testing.T) {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+	// 2026-05-31 20:00 UTC is already Jun 1 in Taipei, which is within the window
+	from := time.Date(2026, time.May, 31, 19, 0, 0, 0, time.UTC).In(taipei)
+	def := intervalDef("1h", time.Time{}, time.Time{}, &ActiveYearlyTimeWindow{StartMonth: 6, StartDay: 1, EndMonth: 6, EndDay: 30})
+
+	result, err := NextFireTimes(def, from, 1)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, time.Date(2026, time.June, 1, 4, 0, 0, 0, taipei), result[0])
+	assert.Equal(t, taipei, result[0].Location())
+}
+
+func TestNextFireTimes_Invalid(t *testing.T) {
+	from := utcTime(2026, time.March, 14, 10, 17, 42)
+	tests := []struct {
+		name string
+		def  ScheduleDef
+	}{
+		{"nil definition", nil},
+		{"invalid interval", intervalDef("10x", time.Time{}, time.Time{}, nil)},
+		{"zero interval", intervalDef("0s", time.Time{}, time.Time{}, nil)},
+		{"negative interval", intervalDef("-1h", time.Time{}, time.Time{}, nil)},
+		{"invalid crontab", cronDef("*/5 * * *", time.Time{}, time.Time{}, nil)},
+		{"invalid fire timestamp", OnceScheduleDef{BaseScheduleDef: BaseScheduleDef{Type: common.DefOnce}}},
+		{"invalid excluded date", calendarDef([]time.Time{from}, []string{"2026/12/25"}, time.Time{}, time.Time{}, nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NextFireTimes(tt.def, from, 1)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNextFireTimes_Count(t *testing.T) {
+	from := utcTime(2026, time.March, 14, 10, 17, 42)
+	def := intervalDef("1s", time.Time{}, time.Time{}, nil)
+
+	fireTimes, err := NextFireTimes(def, from, maxNextFireTimes)
+	require.NoError(t, err)
+	assert.Len(t, fireTimes, maxNextFireTimes)
+
+	fireTimes, err = NextFireTimes(def, from, 0)
+	require.NoError(t, err)
+	assert.Empty(t, fireTimes)
+
+	fireTimes, err = NextFireTimes(OnceScheduleDef{BaseScheduleDef: BaseScheduleDef{Type: common.DefOnce}, FireTimestamp: from.Add(time.Hour).UnixMilli()}, from, maxNextFireTimes)
+	require.NoError(t, err)
+	assert.Len(t, fireTimes, 1)
+
+	_, err = NextFireTimes(def, from, maxNextFireTimes+1)
+	require.Error(t, err)
+	_, err = NextFireTimes(def, from, math.MaxInt)
+	require.Error(t, err)
+}
+
+func TestActiveYearlyTimeWindow_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   ActiveYearlyTimeWindow
+		date     time.Time
+		expected bool
+	}{
+		{"before window", ActiveYearlyTimeWindow{6, 1, 8, 31}, utcTime(2026, time.May, 31, 23, 59, 59), false},
+		{"window start", ActiveYearlyTimeWindow{6, 1, 8, 31}, utcTime(2026, time.June, 1, 0, 0, 0), true},
+		{"window end is inclusive", ActiveYearlyTimeWindow{6, 1, 8, 31}, utcTime(2026, time.August, 31, 23, 59, 59), true},
+		{"after window", ActiveYearlyTimeWindow{6, 1, 8, 31}, utcTime(2026, time.September, 1, 0, 0, 0), false},
+		{"single day", ActiveYearlyTimeWindow{3, 15, 3, 15}, utcTime(2026, time.March, 15, 12, 0, 0), true},
+		{"single day, next day", ActiveYearlyTimeWindow{3, 15, 3, 15}, utcTime(2026, time.March, 16, 0, 0, 0), false},
+		{"year-crossing, before new year", ActiveYearlyTimeWindow{11, 15, 2, 10}, utcTime(2026, time.December, 31, 0, 0, 0), true},
+		{"year-crossing, after new year", ActiveYearlyTimeWindow{11, 15, 2, 10}, utcTime(2027, time.February, 10, 0, 0, 0), true},
+		{"year-crossing, outside", ActiveYearlyTimeWindow{11, 15, 2, 10}, utcTime(2026, time.July, 1, 0, 0, 0), false},
+		{"same-month year-crossing, inside", ActiveYearlyTimeWindow{12, 10, 12, 1}, utcTime(2026, time.December, 1, 0, 0, 0), true},
+		{"same-month year-crossing, outside", ActiveYearlyTimeWindow{12, 10, 12, 1}, utcTime(2026, time.December, 5, 0, 0, 0), false},
+		{"leap day", ActiveYearlyTimeWindow{2, 29, 2, 29}, utcTime(2028, time.February, 29, 0, 0, 0), true},
+		{"leap day, non-leap year", ActiveYearlyTimeWindow{2, 29, 2, 29}, utcTime(2027, time.February, 28, 0, 0, 0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.window.Contains(tt.date))
+		})
+	}
+}
+
+func TestNextFireTimes_CalendarExcludedDatesInLocation(t *testing.T) {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	require.NoError(t, err)
+	// 2026-12-24 20:00 UTC is 2026-12-25 04:00 in Taipei
+	fireTime := time.Date(2026, time.December, 24, 20, 0, 0, 0, time.UTC)
+	def := calendarDef([]time.Time{fireTime}, []string{"2026-12-25"}, time.Time{}, time.Time{}, nil)
+
+	result, err := NextFireTimes(def, utcTime(2026, time.March, 14, 0, 0, 0), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{fireTime}, result)
+
+	result, err = NextFireTimes(def, utcTime(2026, time.March, 14, 0, 0, 0).In(taipei), 1)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func withTimeZone(def ScheduleDef, timeZone string) ScheduleDef {
+	switch d := def.(type) {
+	case IntervalScheduleDef:
+		d.TimeZone = timeZone
+		return d
+	case CronScheduleDef:
+		d.TimeZone = timeZone
+		return d
+	case CalendarScheduleDef:
+		d.TimeZone = timeZone
+		return d
+	}
+	return def
+}
+
+func TestNextFireTimes_TimeZone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	june := &ActiveYearlyTimeWindow{StartMonth: 6, StartDay: 1, EndMonth: 6, EndDay: 30}
+
+	tests := []struct {
+		name     string
+		def      ScheduleDef
+		from     time.Time
+		n        int
+		expected []time.Time
+	}{
+		{"cron, spring forward skips the nonexistent local time",
+			withTimeZone(cronDef("0 30 2 * * *", time.Time{}, time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.March, 6, 12, 0, 0), 3,
+			[]time.Time{utcTime(2026, time.March, 7, 7, 30, 0), utcTime(2026, time.March, 9, 6, 30, 0), utcTime(2026, time.March, 10, 6, 30, 0)}},
+		{"cron, local time kept across spring forward",
+			withTimeZone(cronDef("0 0 9 * * *", time.Time{}, time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.March, 7, 0, 0, 0), 2,
+			[]time.Time{utcTime(2026, time.March, 7, 14, 0, 0), utcTime(2026, time.March, 8, 13, 0, 0)}},
+		{"cron, fall back fires at both repeated local times",
+			withTimeZone(cronDef("0 30 1 * * *", time.Time{}, time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.November, 1, 0, 0, 0), 3,
+			[]time.Time{utcTime(2026, time.November, 1, 5, 30, 0), utcTime(2026, time.November, 1, 6, 30, 0), utcTime(2026, time.November, 2, 6, 30, 0)}},
+		{"cron, CRON_TZ prefix takes precedence",
+			withTimeZone(cronDef("CRON_TZ=UTC 0 0 9 * * *", time.Time{}, time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.March, 7, 0, 0, 0), 1,
+			[]time.Time{utcTime(2026, time.March, 7, 9, 0, 0)}},
+		{"interval, absolute across spring forward",
+			withTimeZone(intervalDef("24h", time.Date(2026, time.March, 7, 9, 0, 0, 0, newYork), time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.March, 7, 0, 0, 0), 2,
+			[]time.Time{utcTime(2026, time.March, 7, 14, 0, 0), utcTime(2026, time.March, 8, 14, 0, 0)}},
+		{"interval, window evaluated in the time zone",
+			withTimeZone(intervalDef("1h", time.Time{}, time.Time{}, june), "Asia/Tokyo"),
+			utcTime(2026, time.May, 31, 13, 30, 0), 1,
+			[]time.Time{utcTime(2026, time.May, 31, 15, 30, 0)}},
+		{"interval, window end evaluated in the time zone",
+			withTimeZone(intervalDef("1h", utcTime(2026, time.June, 30, 12, 0, 0), time.Time{}, june), "America/New_York"),
+			utcTime(2026, time.July, 1, 2, 30, 0), 2,
+			[]time.Time{utcTime(2026, time.July, 1, 3, 0, 0), utcTime(2027, time.June, 1, 4, 0, 0)}},
+		{"calendar, excluded dates evaluated in the time zone",
+			withTimeZone(calendarDef([]time.Time{utcTime(2026, time.December, 25, 3, 0, 0), utcTime(2026, time.December, 26, 3, 0, 0)},
+				[]string{"2026-12-24"}, time.Time{}, time.Time{}, nil), "America/New_York"),
+			utcTime(2026, time.March, 14, 0, 0, 0), 2,
+			[]time.Time{utcTime(2026, time.December, 26, 3, 0, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NextFireTimes(tt.def, tt.from, tt.n)
+			require.NoError(t, err)
+			require.Len(t, result, len(tt.expected))
+			for i := range tt.expected {
+				assert.True(t, tt.expected[i].Equal(result[i]), "expected %s, got %s", tt.expected[i], result[i])
+				assert.Equal(t, time.UTC, result[i].Location())
+			}
+		})
+	}
+}
+
+func TestNextFireTimes_InvalidTimeZone(t *testing.T) {
+	for _, timeZone := range []string{"Mars/Olympus_Mons", "Local"} {
+		t.Run(timeZone, func(t *testing.T) {
+			def := withTimeZone(intervalDef("1h", time.Time{}, time.Time{}, nil), timeZone)
+			_, err := NextFireTimes(def, utcTime(2026, time.March, 14, 0, 0, 0), 1)
+			require.Error(t, err)
+		})
+	}
+}
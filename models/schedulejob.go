@@ -16,9 +16,11 @@ import (
 
 type ScheduleJob struct {
 	DBTimestamp
-	Id                       string
-	Name                     string
+	Id   string
+	Name string
+	// Definition is nil if the job is only triggered by its Dependency
 	Definition               ScheduleDef
+	Dependency               *ScheduleJobDependency
 	AutoTriggerMissedRecords bool
 	Actions                  []ScheduleAction
 	AdminState               AdminState
@@ -32,6 +34,7 @@ func (scheduleJob *ScheduleJob) UnmarshalJSON(b []byte) error {
 		Id                       string
 		Name                     string
 		Definition               any
+		Dependency               *ScheduleJobDependency
 		AutoTriggerMissedRecords bool
 		Actions                  []any
 		AdminState               AdminState
@@ -43,9 +46,13 @@ func (scheduleJob *ScheduleJob) UnmarshalJSON(b []byte) error {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal ScheduleJob.", err)
 	}
 
-	def, err := instantiateScheduleDef(alias.Definition)
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+	var def ScheduleDef
+	if alias.Definition != nil {
+		var err error
+		def, err = instantiateScheduleDef(alias.Definition)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
 
 	actions := make([]ScheduleAction, len(alias.Actions))
@@ -62,6 +69,7 @@ func (scheduleJob *ScheduleJob) UnmarshalJSON(b []byte) error {
 		Id:                       alias.Id,
 		Name:                     alias.Name,
 		Definition:               def,
+		Dependency:               alias.Dependency,
 		AutoTriggerMissedRecords: alias.AutoTriggerMissedRecords,
 		Actions:                  actions,
 		AdminState:               alias.AdminState,
@@ -104,6 +112,18 @@ func unmarshalScheduleDef(b []byte) (def ScheduleDef, err error) {
 			return def, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal CRON ScheduleDef.", err)
 		}
 		def = cronDef
+	case common.DefOnce:
+		var onceDef OnceScheduleDef
+		if err = json.Unmarshal(b, &onceDef); err != nil {
+			return def, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal ONCE ScheduleDef.", err)
+		}
+		def = onceDef
+	case common.DefCalendar:
+		var calendarDef CalendarScheduleDef
+		if err = json.Unmarshal(b, &calendarDef); err != nil {
+			return def, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal CALENDAR ScheduleDef.", err)
+		}
+		def = calendarDef
 	default:
 		return def, errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported schedule definition type", err)
 	}
@@ -117,6 +137,9 @@ type BaseScheduleDef struct {
 	// ActiveYearlyTimeWindow is an optional recurring within-year active period. When nil, the schedule
 	// has no window constraint and fires on every tick within its lifetime. See ActiveYearlyTimeWindow.
 	ActiveYearlyTimeWindow *ActiveYearlyTimeWindow
+	// TimeZone is the optional IANA time zone, e.g. America/New_York, in which the ActiveYearlyTimeWindow, the
+	// crontab and the CalendarScheduleDef.ExcludedDates are evaluated
+	TimeZone string
 }
 
 // ActiveYearlyTimeWindow describes a recurring month/day range within a year. The rules are:
@@ -152,6 +175,28 @@ func (c CronScheduleDef) GetBaseScheduleDef() BaseScheduleDef {
 	return c.BaseScheduleDef
 }
 
+type OnceScheduleDef struct {
+	BaseScheduleDef
+	// FireTimestamp is the time in milliseconds to execute the job once
+	FireTimestamp int64
+}
+
+func (o OnceScheduleDef) GetBaseScheduleDef() BaseScheduleDef {
+	return o.BaseScheduleDef
+}
+
+type CalendarScheduleDef struct {
+	BaseScheduleDef
+	// FireTimestamps are the times in milliseconds to execute the job
+	FireTimestamps []int64
+	// ExcludedDates are the dates in the YYYY-MM-DD format on which the job is not executed, e.g. plant holidays
+	ExcludedDates []string
+}
+
+func (c CalendarScheduleDef) GetBaseScheduleDef() BaseScheduleDef {
+	return c.BaseScheduleDef
+}
+
 type ScheduleAction interface {
 	GetBaseScheduleAction() BaseScheduleAction
 	// WithEmptyPayloadAndId returns a copy of the ScheduleAction with empty payload and Id, which is used by ScheduleActionRecord to remove the payload and id before storing the record into database
@@ -195,6 +240,18 @@ func UnmarshalScheduleAction(b []byte) (action ScheduleAction, err error) {
 			return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal DEVICECONTROL ScheduleAction.", err)
 		}
 		action = deviceControlAction
+	case common.ActionNotification:
+		var notificationAction NotificationAction
+		if err = json.Unmarshal(b, &notificationAction); err != nil {
+			return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal NOTIFICATION ScheduleAction.", err)
+		}
+		action = notificationAction
+	case common.ActionKVS:
+		var kvsAction KVSAction
+		if err = json.Unmarshal(b, &kvsAction); err != nil {
+			return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal KVS ScheduleAction.", err)
+		}
+		action = kvsAction
 	default:
 		return action, errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported schedule action type", err)
 	}
@@ -207,6 +264,12 @@ type BaseScheduleAction struct {
 	Type        ScheduleActionType
 	ContentType string
 	Payload     []byte
+	// RetryCount is the number of retries after the first attempt fails
+	RetryCount int
+	// RetryBackoff is the waiting time before each retry
+	RetryBackoff string
+	// Timeout is the time limit of each attempt
+	Timeout string
 }
 
 type EdgeXMessageBusAction struct {
@@ -285,8 +348,58 @@ func (d DeviceControlAction) WithId(id string) ScheduleAction {
 	return d
 }
 
-// ScheduleDefType is used to identify the schedule definition type, i.e., INTERVAL or CRON
+// NotificationAction sends the notification carried by the payload, which has the shape of the Notification DTO
+type NotificationAction struct {
+	BaseScheduleAction
+}
+
+func (n NotificationAction) GetBaseScheduleAction() BaseScheduleAction {
+	return n.BaseScheduleAction
+}
+func (n NotificationAction) WithEmptyPayloadAndId() ScheduleAction {
+	n.Id = ""
+	n.Payload = nil
+	return n
+}
+func (n NotificationAction) WithId(id string) ScheduleAction {
+	if len(n.Id) == 0 {
+		if id != "" {
+			n.Id = id
+		} else {
+			n.Id = uuid.New().String()
+		}
+	}
+	return n
+}
+
+// KVSAction updates the values of the key with the payload, which has the shape of the UpdateKeysRequest DTO
+type KVSAction struct {
+	BaseScheduleAction
+	Key     string
+	Flatten bool
+}
+
+func (k KVSAction) GetBaseScheduleAction() BaseScheduleAction {
+	return k.BaseScheduleAction
+}
+func (k KVSAction) WithEmptyPayloadAndId() ScheduleAction {
+	k.Id = ""
+	k.Payload = nil
+	return k
+}
+func (k KVSAction) WithId(id string) ScheduleAction {
+	if len(k.Id) == 0 {
+		if id != "" {
+			k.Id = id
+		} else {
+			k.Id = uuid.New().String()
+		}
+	}
+	return k
+}
+
+// ScheduleDefType is used to identify the schedule definition type, i.e., INTERVAL, CRON, ONCE or CALENDAR
 type ScheduleDefType string
 
-// ScheduleActionType is used to identify the schedule action type, i.e., EDGEXMESSAGEBUS, REST, or DEVICECONTROL
+// ScheduleActionType is used to identify the schedule action type, i.e., EDGEXMESSAGEBUS, REST, DEVICECONTROL, NOTIFICATION, or KVS
 type ScheduleActionType string
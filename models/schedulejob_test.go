@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -33,6 +33,43 @@ var cronScheduleDef = CronScheduleDef{
 	Crontab: TestCrontab,
 }
 
+var onceScheduleDef = OnceScheduleDef{
+	BaseScheduleDef: BaseScheduleDef{
+		Type: common.DefOnce,
+	},
+	FireTimestamp: TestStartTimestamp,
+}
+
+var calendarScheduleDef = CalendarScheduleDef{
+	BaseScheduleDef: BaseScheduleDef{
+		Type:           common.DefCalendar,
+		StartTimestamp: TestStartTimestamp,
+		EndTimestamp:   TestEndTimestamp,
+	},
+	FireTimestamps: []int64{TestStartTimestamp, TestEndTimestamp},
+	ExcludedDates:  []string{"2026-12-25"},
+}
+
+var scheduleJobWithInvalidOnceScheduleDef = `{
+	"id": "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc",
+	"name": "TestScheduleJob",
+	"definition": {
+		"Type": "ONCE",
+		"FireTimestamp": "1724052774"
+	},
+	"actions": []
+}`
+
+var scheduleJobWithInvalidCalendarScheduleDef = `{
+	"id": "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc",
+	"name": "TestScheduleJob",
+	"definition": {
+		"Type": "CALENDAR",
+		"FireTimestamps": 1724052774
+	},
+	"actions": []
+}`
+
 var scheduleJobWithInvalidIntervalScheduleDef = `{
 	"id": "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc",
 	"name": "TestScheduleJob",
@@ -134,6 +171,21 @@ var scheduleJobWithInvalidDeviceControlAction = `{
 	]
 }`
 
+var scheduleJobWithInvalidKVSAction = `{
+	"id": "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc",
+	"name": "TestScheduleJob",
+	"definition": {
+		"Type": "INTERVAL",
+		"Interval": "10m"
+	},
+	"actions": [
+		{
+			"type": "KVS",
+			"key": ["edgex/v4/core-data"]
+		}
+	]
+}`
+
 var scheduleJobWithUnsupportedAction = `{
 	"id": "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc",
 	"name": "TestScheduleJob",
@@ -193,6 +245,26 @@ var deviceControlAction = DeviceControlAction{
 	SourceName: TestSourceName,
 }
 
+var notificationAction = NotificationAction{
+	BaseScheduleAction: BaseScheduleAction{
+		Id:          ExampleUUID,
+		Type:        common.ActionNotification,
+		ContentType: TestContentType,
+		Payload:     []byte(TestPayload),
+	},
+}
+
+var kvsAction = KVSAction{
+	BaseScheduleAction: BaseScheduleAction{
+		Id:          ExampleUUID,
+		Type:        common.ActionKVS,
+		ContentType: TestContentType,
+		Payload:     []byte(TestPayload),
+	},
+	Key:     "edgex/v4/core-data",
+	Flatten: true,
+}
+
 func scheduleJobWithINTERVALScheduleDef() ScheduleJob {
 	return ScheduleJob{
 		DBTimestamp:              DBTimestamp{},
@@ -210,11 +282,32 @@ func scheduleJobWithCRONScheduleDef() ScheduleJob {
 		Id:                       ExampleUUID,
 		Name:                     TestScheduleJobName,
 		Definition:               cronScheduleDef,
+		Dependency:               &ScheduleJobDependency{JobName: "upstream-job", Condition: common.DependencyOnSucceeded},
 		AutoTriggerMissedRecords: false,
 		Actions:                  []ScheduleAction{},
 	}
 }
 
+func scheduleJobWithScheduleDef(def ScheduleDef) ScheduleJob {
+	return ScheduleJob{
+		DBTimestamp: DBTimestamp{},
+		Id:          ExampleUUID,
+		Name:        TestScheduleJobName,
+		Definition:  def,
+		Actions:     []ScheduleAction{},
+	}
+}
+
+func scheduleJobWithScheduleActions(actions ...ScheduleAction) ScheduleJob {
+	return ScheduleJob{
+		DBTimestamp: DBTimestamp{},
+		Id:          ExampleUUID,
+		Name:        TestScheduleJobName,
+		Definition:  intervalScheduleDef,
+		Actions:     actions,
+	}
+}
+
 func scheduleJobWithEDGEXMESSAGEBUSScheduleAction() ScheduleJob {
 	return ScheduleJob{
 		DBTimestamp: DBTimestamp{},
@@ -256,6 +349,14 @@ func TestScheduleJob_UnmarshalJSON(t *testing.T) {
 	scheduleJobWithCronScheduleDefJsonData, err := json.Marshal(scheduleJobWithCronScheduleDef)
 	require.NoError(t, err)
 
+	scheduleJobWithOnceScheduleDef := scheduleJobWithScheduleDef(onceScheduleDef)
+	scheduleJobWithOnceScheduleDefJsonData, err := json.Marshal(scheduleJobWithOnceScheduleDef)
+	require.NoError(t, err)
+
+	scheduleJobWithCalendarScheduleDef := scheduleJobWithScheduleDef(calendarScheduleDef)
+	scheduleJobWithCalendarScheduleDefJsonData, err := json.Marshal(scheduleJobWithCalendarScheduleDef)
+	require.NoError(t, err)
+
 	scheduleJobWithEdgeXMessageBusScheduleAction := scheduleJobWithEDGEXMESSAGEBUSScheduleAction()
 	scheduleJobWithEdgeXMessageBusScheduleActionJsonData, err := json.Marshal(scheduleJobWithEdgeXMessageBusScheduleAction)
 	require.NoError(t, err)
@@ -264,6 +365,14 @@ func TestScheduleJob_UnmarshalJSON(t *testing.T) {
 	scheduleJobWithRestScheduleActionJsonData, err := json.Marshal(scheduleJobWithRestScheduleAction)
 	require.NoError(t, err)
 
+	scheduleJobWithNotificationScheduleAction := scheduleJobWithScheduleActions(notificationAction)
+	scheduleJobWithNotificationScheduleActionJsonData, err := json.Marshal(scheduleJobWithNotificationScheduleAction)
+	require.NoError(t, err)
+
+	scheduleJobWithKVSScheduleAction := scheduleJobWithScheduleActions(kvsAction)
+	scheduleJobWithKVSScheduleActionJsonData, err := json.Marshal(scheduleJobWithKVSScheduleAction)
+	require.NoError(t, err)
+
 	scheduleJobWithDeviceControlScheduleAction := scheduleJobWithDEVICECONTROLScheduleAction()
 	scheduleJobWithDeviceControlScheduleActionJsonData, err := json.Marshal(scheduleJobWithDeviceControlScheduleAction)
 	require.NoError(t, err)
@@ -278,6 +387,10 @@ func TestScheduleJob_UnmarshalJSON(t *testing.T) {
 		{"unmarshal ScheduleJob with invalid INTERVAL ScheduleDef", ScheduleJob{}, []byte(scheduleJobWithInvalidIntervalScheduleDef), true},
 		{"valid, unmarshal ScheduleJob with CRON ScheduleDef", scheduleJobWithCronScheduleDef, scheduleJobWithCronScheduleDefJsonData, false},
 		{"unmarshal ScheduleJob with invalid CRON ScheduleDef", scheduleJobWithCronScheduleDef, []byte(scheduleJobWithInvalidCronScheduleDef), true},
+		{"valid, unmarshal ScheduleJob with ONCE ScheduleDef", scheduleJobWithOnceScheduleDef, scheduleJobWithOnceScheduleDefJsonData, false},
+		{"unmarshal ScheduleJob with invalid ONCE ScheduleDef", ScheduleJob{}, []byte(scheduleJobWithInvalidOnceScheduleDef), true},
+		{"valid, unmarshal ScheduleJob with CALENDAR ScheduleDef", scheduleJobWithCalendarScheduleDef, scheduleJobWithCalendarScheduleDefJsonData, false},
+		{"unmarshal ScheduleJob with invalid CALENDAR ScheduleDef", ScheduleJob{}, []byte(scheduleJobWithInvalidCalendarScheduleDef), true},
 		{"unmarshal ScheduleJob with unsupported ScheduleDef", ScheduleJob{}, []byte(scheduleJobWithUnsupportedScheduleDef), true},
 		{"unmarshal ScheduleJob with invalid ScheduleDef", ScheduleJob{}, []byte(scheduleJobWithInvalidScheduleDef), true},
 		{"valid, unmarshal ScheduleJob with EDGEXMESSAGEBUS ScheduleAction", scheduleJobWithEdgeXMessageBusScheduleAction, scheduleJobWithEdgeXMessageBusScheduleActionJsonData, false},
@@ -286,6 +399,9 @@ func TestScheduleJob_UnmarshalJSON(t *testing.T) {
 		{"unmarshal ScheduleJob with invalid REST ScheduleAction", ScheduleJob{}, []byte(scheduleJobWithInvalidRestAction), true},
 		{"valid, unmarshal ScheduleJob with DEVICECONTROL ScheduleAction", scheduleJobWithDeviceControlScheduleAction, scheduleJobWithDeviceControlScheduleActionJsonData, false},
 		{"unmarshal ScheduleJob with invalid DEVICECONTROL ScheduleAction", ScheduleJob{}, []byte(scheduleJobWithInvalidDeviceControlAction), true},
+		{"valid, unmarshal ScheduleJob with NOTIFICATION ScheduleAction", scheduleJobWithNotificationScheduleAction, scheduleJobWithNotificationScheduleActionJsonData, false},
+		{"valid, unmarshal ScheduleJob with KVS ScheduleAction", scheduleJobWithKVSScheduleAction, scheduleJobWithKVSScheduleActionJsonData, false},
+		{"unmarshal ScheduleJob with invalid KVS ScheduleAction", ScheduleJob{}, []byte(scheduleJobWithInvalidKVSAction), true},
 		{"unmarshal ScheduleJob with unsupported ScheduleAction", ScheduleJob{}, []byte(scheduleJobWithUnsupportedAction), true},
 		{"unmarshal ScheduleJob with invalid ScheduleAction", ScheduleJob{}, []byte(scheduleJobWithInvalidScheduleAction), true},
 		{"unmarshal invalid ScheduleJob, invalid data", ScheduleJob{}, []byte(`{"Created": [1]}`), true},
@@ -315,6 +431,8 @@ func TestScheduleAction_GetBaseScheduleAction(t *testing.T) {
 		{"EdgeXMessageBusAction", edgeXMessageBusAction, edgeXMessageBusAction.BaseScheduleAction},
 		{"RESTAction", restAction, restAction.BaseScheduleAction},
 		{"DeviceControlAction", deviceControlAction, deviceControlAction.BaseScheduleAction},
+		{"NotificationAction", notificationAction, notificationAction.BaseScheduleAction},
+		{"KVSAction", kvsAction, kvsAction.BaseScheduleAction},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -333,6 +451,8 @@ func TestScheduleAction_WithEmptyPayloadAndId(t *testing.T) {
 		{"EdgeXMessageBusAction", edgeXMessageBusAction, edgeXMessageBusAction.WithEmptyPayloadAndId()},
 		{"RESTAction", restAction, restAction.WithEmptyPayloadAndId()},
 		{"DeviceControlAction", deviceControlAction, deviceControlAction.WithEmptyPayloadAndId()},
+		{"NotificationAction", notificationAction, notificationAction.WithEmptyPayloadAndId()},
+		{"KVSAction", kvsAction, kvsAction.WithEmptyPayloadAndId()},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,6 +471,8 @@ func TestScheduleAction_SetIdIfNotExists(t *testing.T) {
 		{"EdgeXMessageBusAction", edgeXMessageBusAction},
 		{"RESTAction", restAction},
 		{"DeviceControlAction", deviceControlAction},
+		{"NotificationAction", NotificationAction{}},
+		{"KVSAction", KVSAction{}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
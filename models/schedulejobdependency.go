@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// ScheduleJobDependencyCondition indicates which completion of the upstream job triggers the dependent job
+type ScheduleJobDependencyCondition string
+
+// ScheduleJobDependency triggers a ScheduleJob when its upstream job completes, in addition to its ScheduleDef
+type ScheduleJobDependency struct {
+	// JobName is the name of the upstream job
+	JobName string
+	// Condition is COMPLETED to trigger on any completion of the upstream job, or SUCCEEDED to trigger on success only
+	Condition ScheduleJobDependencyCondition
+}
+
+// ValidateScheduleJobDependencies checks that the dependencies of the jobs don't form a cycle. The returned error
+// describes the cycle from a job to its upstream jobs, e.g. "job-a -> job-b -> job-a".
+func ValidateScheduleJobDependencies(jobs []ScheduleJob) errors.EdgeX {
+	upstream := make(map[string]string, len(jobs))
+	for _, job := range jobs {
+		if job.Dependency != nil {
+			upstream[job.Name] = job.Dependency.JobName
+		}
+	}
+
+	// a job is checked once no cycle is reachable from it
+	checked := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		var chain []string
+		indexes := make(map[string]int)
+		for name := job.Name; name != "" && !checked[name]; name = upstream[name] {
+			if i, ok := indexes[name]; ok {
+				cycle := append(chain[i:], name)
+				return errors.NewCommonEdgeX(errors.KindContractInvalid,
+					fmt.Sprintf("schedule job dependency cycle detected: %s", strings.Join(cycle, " -> ")), nil)
+			}
+			indexes[name] = len(chain)
+			chain = append(chain, name)
+		}
+		for _, name := range chain {
+			checked[name] = true
+		}
+	}
+	return nil
+}
+
+// ValidateScheduleJobDependency checks the job to be added or updated against the existing jobs, in which the job of
+// the same name is replaced. The job should have a ScheduleDef or a dependency, and its dependency should not form a
+// cycle with the existing jobs. The cycle can't be detected by the request validation, so the caller should check
+// the job before saving it.
+func ValidateScheduleJobDependency(job ScheduleJob, existing []ScheduleJob) errors.EdgeX {
+	if job.Definition == nil && job.Dependency == nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("schedule job %s should have either a schedule definition or a dependency", job.Name), nil)
+	}
+	if job.Dependency == nil {
+		// the job without dependency can't be a part of a cycle
+		return nil
+	}
+	// the job comes first so that the detected cycle is described from the job
+	jobs := make([]ScheduleJob, 1, len(existing)+1)
+	jobs[0] = job
+	for _, j := range existing {
+		if j.Name != job.Name {
+			jobs = append(jobs, j)
+		}
+	}
+	return ValidateScheduleJobDependencies(jobs)
+}
+
+// DependentScheduleJobs returns the unlocked jobs which should be triggered when the upstream job completes with the
+// status. A SUCCEEDED or FAILED completion triggers the COMPLETED dependencies, and only a SUCCEEDED completion
+// triggers the SUCCEEDED dependencies. A MISSED run is not a completion and triggers nothing.
+func DependentScheduleJobs(jobs []ScheduleJob, jobName string, status ScheduleActionRecordStatus) []ScheduleJob {
+	if status != Succeeded && status != Failed {
+		return nil
+	}
+	var dependents []ScheduleJob
+	for _, job := range jobs {
+		if job.Dependency == nil || job.Dependency.JobName != jobName || job.AdminState == Locked {
+			continue
+		}
+		switch job.Dependency.Condition {
+		case common.DependencyOnCompleted:
+			dependents = append(dependents, job)
+		case common.DependencyOnSucceeded:
+			if status == Succeeded {
+				dependents = append(dependents, job)
+			}
+		}
+	}
+	return dependents
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+func dependentJob(name, upstream string, condition ScheduleJobDependencyCondition) ScheduleJob {
+	return ScheduleJob{
+		Name:       name,
+		Dependency: &ScheduleJobDependency{JobName: upstream, Condition: condition},
+		AdminState: Unlocked,
+	}
+}
+
+func TestValidateScheduleJobDependencies(t *testing.T) {
+	flush := ScheduleJob{Name: "flush"}
+	export := dependentJob("export", "flush", common.DependencyOnSucceeded)
+	notify := dependentJob("notify", "export", common.DependencyOnCompleted)
+
+	tests := []struct {
+		name          string
+		jobs          []ScheduleJob
+		expectedCycle string
+	}{
+		{"valid, no dependency", []ScheduleJob{flush}, ""},
+		{"valid, chain", []ScheduleJob{notify, export, flush}, ""},
+		{"valid, upstream job not found", []ScheduleJob{notify}, ""},
+		{"valid, fan-out", []ScheduleJob{flush, export, dependentJob("audit", "flush", common.DependencyOnCompleted)}, ""},
+		{"invalid, depends on itself", []ScheduleJob{dependentJob("loop", "loop", common.DependencyOnCompleted)}, "loop -> loop"},
+		{"invalid, cycle of two", []ScheduleJob{
+			dependentJob("a", "b", common.DependencyOnCompleted),
+			dependentJob("b", "a", common.DependencyOnSucceeded),
+		}, "a -> b -> a"},
+		{"invalid, cycle reached from a chain", []ScheduleJob{
+			dependentJob("head", "a", common.DependencyOnCompleted),
+			dependentJob("a", "b", common.DependencyOnCompleted),
+			dependentJob("b", "c", common.DependencyOnCompleted),
+			dependentJob("c", "a", common.DependencyOnCompleted),
+		}, "a -> b -> c -> a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleJobDependencies(tt.jobs)
+			if tt.expectedCycle == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedCycle)
+		})
+	}
+}
+
+func TestValidateScheduleJobDependency(t *testing.T) {
+	flush := ScheduleJob{Name: "flush", Definition: CronScheduleDef{Crontab: "0 * * * *"}}
+	export := dependentJob("export", "flush", common.DependencyOnSucceeded)
+	existing := []ScheduleJob{flush, export}
+
+	updatedFlush := flush
+	updatedFlush.Dependency = &ScheduleJobDependency{JobName: "export", Condition: common.DependencyOnCompleted}
+	removedDependency := export
+	removedDependency.Dependency = nil
+
+	tests := []struct {
+		name        string
+		job         ScheduleJob
+		expectedErr string
+	}{
+		{"valid, new job with dependency", dependentJob("notify", "export", common.DependencyOnCompleted), ""},
+		{"valid, updated job without dependency", flush, ""},
+		{"valid, dependency only", export, ""},
+		{"invalid, update forms a cycle", updatedFlush, "flush -> export -> flush"},
+		{"invalid, neither definition nor dependency", removedDependency, "either a schedule definition or a dependency"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleJobDependency(tt.job, existing)
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestDependentScheduleJobs(t *testing.T) {
+	onSuccess := dependentJob("export", "flush", common.DependencyOnSucceeded)
+	onCompletion := dependentJob("audit", "flush", common.DependencyOnCompleted)
+	locked := dependentJob("locked", "flush", common.DependencyOnCompleted)
+	locked.AdminState = Locked
+	other := dependentJob("other", "backup", common.DependencyOnCompleted)
+	jobs := []ScheduleJob{{Name: "flush"}, onSuccess, onCompletion, locked, other}
+
+	tests := []struct {
+		name     string
+		status   ScheduleActionRecordStatus
+		expected []ScheduleJob
+	}{
+		{"succeeded", Succeeded, []ScheduleJob{onSuccess, onCompletion}},
+		{"failed", Failed, []ScheduleJob{onCompletion}},
+		{"missed", Missed, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DependentScheduleJobs(jobs, "flush", tt.status))
+		})
+	}
+}
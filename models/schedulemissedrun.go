@@ -0,0 +1,178 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DefaultMaxMissedRuns is the cap of the missed runs per action when the specified cap is not positive
+const DefaultMaxMissedRuns = 100
+
+// missedRunBatchSize is the number of fire times computed at a time when searching the missed runs
+const missedRunBatchSize = 1000
+
+// MissedRunPolicy defines how the missed runs of a ScheduleJob are caught up
+type MissedRunPolicy string
+
+const (
+	// MissedRunPolicyAll catches up all the missed runs, up to the cap
+	MissedRunPolicyAll MissedRunPolicy = "ALL"
+	// MissedRunPolicyLatest catches up the latest missed run only
+	MissedRunPolicyLatest MissedRunPolicy = "LATEST"
+	// MissedRunPolicySkip doesn't catch up any missed run
+	MissedRunPolicySkip MissedRunPolicy = "SKIP"
+)
+
+// MissedRuns is the missed fire times of a schedule action
+type MissedRuns struct {
+	Action ScheduleAction
+	// ScheduledAt are the missed fire times in milliseconds, in ascending order
+	ScheduledAt []int64
+}
+
+// ComputeMissedRuns returns the fire times of each job action which were missed between its latest record and now,
+// e.g. while the scheduler was down. The latest records are usually queried by
+// ScheduleActionRecordClient.LatestScheduleActionRecordsByJobName. An action without its own record falls back to
+// the latest record of the job, and no run is missed if the job has no record at all or the latest record has no
+// ScheduledAt. The fire time equal to now is not missed. With MissedRunPolicyAll, only the most recent maxRuns missed
+// runs are returned per action. Nothing is returned if the job doesn't enable AutoTriggerMissedRecords or it is only
+// triggered by its dependency.
+func ComputeMissedRuns(job ScheduleJob, latestRecords []ScheduleActionRecord, now time.Time, policy MissedRunPolicy,
+	maxRuns int) ([]MissedRuns, errors.EdgeX) {
+	switch policy {
+	case MissedRunPolicyAll, MissedRunPolicyLatest:
+	case MissedRunPolicySkip:
+		return nil, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported missed run policy '%s'", policy), nil)
+	}
+	if !job.AutoTriggerMissedRecords || job.Definition == nil || len(latestRecords) == 0 {
+		return nil, nil
+	}
+	if policy == MissedRunPolicyLatest {
+		maxRuns = 1
+	} else if maxRuns <= 0 {
+		maxRuns = DefaultMaxMissedRuns
+	}
+
+	var jobLatest int64
+	actionLatest := make(map[string]int64, len(latestRecords))
+	for _, record := range latestRecords {
+		jobLatest = max(jobLatest, record.ScheduledAt)
+		if record.Action == nil {
+			continue
+		}
+		if id := record.Action.GetBaseScheduleAction().Id; id != "" {
+			actionLatest[id] = max(actionLatest[id], record.ScheduledAt)
+		}
+	}
+
+	var result []MissedRuns
+	for _, action := range job.Actions {
+		latest, ok := actionLatest[action.GetBaseScheduleAction().Id]
+		if !ok {
+			latest = jobLatest
+		}
+		if latest <= 0 {
+			// a record without the scheduled time doesn't tell when the action ran
+			continue
+		}
+		scheduledAt, err := missedFireTimes(job.Definition, time.UnixMilli(latest), now, maxRuns)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if len(scheduledAt) > 0 {
+			result = append(result, MissedRuns{Action: action, ScheduledAt: scheduledAt})
+		}
+	}
+	return result, nil
+}
+
+// missedFireTimes returns the most recent maxRuns fire times after the latest run and before now in milliseconds.
+// Instead of walking all the fire times since the latest run, which could be a long time ago, the fire times are
+// searched within a span before now, and the span is doubled until enough fire times are found or it reaches the
+// latest run, so that the work is bounded by the number of fire times near now.
+func missedFireTimes(def ScheduleDef, latest, now time.Time, maxRuns int) ([]int64, errors.EdgeX) {
+	if def == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "schedule definition must not be nil", nil)
+	}
+	if end := def.GetBaseScheduleDef().EndTimestamp; end > 0 && time.UnixMilli(end).Before(now) {
+		// search back from the end of the schedule, and the fire time at the end time is still missed
+		now = time.UnixMilli(end).Add(time.Nanosecond)
+	}
+	if !latest.Before(now) {
+		return nil, nil
+	}
+	span := missedRunSearchSpan(def, maxRuns)
+	for {
+		from := latest
+		if span > 0 && span < now.Sub(latest) {
+			from = alignMissedRunSearch(def, latest, now.Add(-span))
+		}
+		missed, err := fireTimesBetween(def, from, now, maxRuns)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if len(missed) >= maxRuns || from.Equal(latest) {
+			return missed, nil
+		}
+		span *= 2
+	}
+}
+
+// missedRunSearchSpan returns the initial span before now to search the missed runs
+func missedRunSearchSpan(def ScheduleDef, maxRuns int) time.Duration {
+	interval := time.Second
+	if d, ok := def.(IntervalScheduleDef); ok {
+		if valid, v := common.ParseDurationWithDay(d.Interval); valid && v > interval {
+			interval = v
+		}
+	}
+	return time.Duration(maxRuns+1) * interval
+}
+
+// alignMissedRunSearch aligns the search start to the fire times of the latest run, since the fire times of an
+// IntervalScheduleDef without StartTimestamp are anchored at the time it starts from
+func alignMissedRunSearch(def ScheduleDef, latest, from time.Time) time.Time {
+	d, ok := def.(IntervalScheduleDef)
+	if !ok || d.StartTimestamp > 0 {
+		return from
+	}
+	valid, interval := common.ParseDurationWithDay(d.Interval)
+	if !valid || interval <= 0 {
+		return from
+	}
+	return latest.Add(from.Sub(latest) / interval * interval)
+}
+
+// fireTimesBetween returns the most recent maxRuns fire times after from and before now in milliseconds
+func fireTimesBetween(def ScheduleDef, from, now time.Time, maxRuns int) ([]int64, errors.EdgeX) {
+	var result []int64
+	for {
+		fireTimes, err := NextFireTimes(def, from, missedRunBatchSize)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, t := range fireTimes {
+			if !t.Before(now) {
+				return result, nil
+			}
+			result = append(result, t.UnixMilli())
+			if len(result) > maxRuns {
+				result = result[1:]
+			}
+		}
+		if len(fireTimes) < missedRunBatchSize {
+			return result, nil
+		}
+		from = fireTimes[len(fireTimes)-1]
+	}
+}
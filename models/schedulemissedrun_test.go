@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+const (
+	testActionId1 = "1b2f5a32-4d6e-4a40-9a8f-1fd1b3a0c001"
+	testActionId2 = "1b2f5a32-4d6e-4a40-9a8f-1fd1b3a0c002"
+)
+
+func TestComputeMissedRuns(t *testing.T) {
+	now := utcTime(2026, time.March, 14, 10, 0, 0)
+	action1 := RESTAction{BaseScheduleAction: BaseScheduleAction{Id: testActionId1, Type: common.ActionREST}, Address: TestAddress, Method: TestHTTPMethod}
+	action2 := EdgeXMessageBusAction{BaseScheduleAction: BaseScheduleAction{Id: testActionId2, Type: common.ActionEdgeXMessageBus}, Topic: TestTopic}
+	job := ScheduleJob{
+		Name:                     TestScheduleJobName,
+		Definition:               cronDef("0 * * * *", time.Time{}, time.Time{}, nil),
+		AutoTriggerMissedRecords: true,
+		Actions:                  []ScheduleAction{action1, action2},
+	}
+	record := func(action ScheduleAction, scheduledAt time.Time) ScheduleActionRecord {
+		return ScheduleActionRecord{JobName: TestScheduleJobName, Action: action.WithEmptyPayloadAndId().WithId(action.GetBaseScheduleAction().Id),
+			Status: Succeeded, ScheduledAt: scheduledAt.UnixMilli()}
+	}
+	hours := func(hours ...int) []int64 {
+		result := make([]int64, len(hours))
+		for i, h := range hours {
+			result[i] = utcTime(2026, time.March, 14, h, 0, 0).UnixMilli()
+		}
+		return result
+	}
+
+	disabledJob := job
+	disabledJob.AutoTriggerMissedRecords = false
+	intervalJob := job
+	intervalJob.Definition = intervalDef("30m", utcTime(2026, time.March, 14, 0, 0, 0), time.Time{}, nil)
+	dependencyOnlyJob := job
+	dependencyOnlyJob.Definition = nil
+	dependencyOnlyJob.Dependency = &ScheduleJobDependency{JobName: "upstream", Condition: common.DependencyOnCompleted}
+	endedJob := job
+	endedJob.Definition = cronDef("0 * * * *", time.Time{}, utcTime(2026, time.March, 14, 7, 0, 0), nil)
+
+	tests := []struct {
+		name          string
+		job           ScheduleJob
+		latestRecords []ScheduleActionRecord
+		policy        MissedRunPolicy
+		maxRuns       int
+		expected      []MissedRuns
+	}{
+		{"all", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 6, 0, 0)), record(action2, utcTime(2026, time.March, 14, 8, 0, 0))},
+			MissedRunPolicyAll, 0, []MissedRuns{{action1, hours(7, 8, 9)}, {action2, hours(9)}}},
+		{"all with cap keeps the most recent runs", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 1, 0, 0))},
+			MissedRunPolicyAll, 2, []MissedRuns{{action1, hours(8, 9)}, {action2, hours(8, 9)}}},
+		{"latest only", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 6, 0, 0)), record(action2, utcTime(2026, time.March, 14, 8, 0, 0))},
+			MissedRunPolicyLatest, 0, []MissedRuns{{action1, hours(9)}, {action2, hours(9)}}},
+		{"skip", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 6, 0, 0))},
+			MissedRunPolicySkip, 0, nil},
+		{"action without record falls back to the job's latest record", job, []ScheduleActionRecord{record(action2, utcTime(2026, time.March, 14, 7, 0, 0))},
+			MissedRunPolicyAll, 0, []MissedRuns{{action1, hours(8, 9)}, {action2, hours(8, 9)}}},
+		{"nothing missed", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 9, 0, 0)), record(action2, utcTime(2026, time.March, 14, 9, 0, 0))},
+			MissedRunPolicyAll, 0, nil},
+		{"fire time at now is not missed", job, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 8, 30, 0))},
+			MissedRunPolicyAll, 0, []MissedRuns{{action1, hours(9)}, {action2, hours(9)}}},
+		{"no records", job, nil, MissedRunPolicyAll, 0, nil},
+		{"records without scheduled time", job, []ScheduleActionRecord{{JobName: TestScheduleJobName, Action: action1, Status: Succeeded}},
+			MissedRunPolicyAll, 0, nil},
+		{"dependency only", dependencyOnlyJob, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 6, 0, 0))},
+			MissedRunPolicyAll, 0, nil},
+		{"auto trigger disabled", disabledJob, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 6, 0, 0))},
+			MissedRunPolicyAll, 0, nil},
+		{"interval", intervalJob, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 8, 0, 0)), record(action2, utcTime(2026, time.March, 14, 9, 30, 0))},
+			MissedRunPolicyAll, 0, []MissedRuns{{action1, []int64{
+				utcTime(2026, time.March, 14, 8, 30, 0).UnixMilli(), utcTime(2026, time.March, 14, 9, 0, 0).UnixMilli(), utcTime(2026, time.March, 14, 9, 30, 0).UnixMilli()}}}},
+		{"end timestamp", endedJob, []ScheduleActionRecord{record(action1, utcTime(2026, time.March, 14, 5, 0, 0))},
+			MissedRunPolicyAll, 0, []MissedRuns{{action1, hours(6, 7)}, {action2, hours(6, 7)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ComputeMissedRuns(tt.job, tt.latestRecords, now, tt.policy, tt.maxRuns)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestComputeMissedRuns_DefaultCap(t *testing.T) {
+	now := utcTime(2026, time.March, 14, 10, 0, 0)
+	action := RESTAction{BaseScheduleAction: BaseScheduleAction{Id: testActionId1, Type: common.ActionREST}}
+	job := ScheduleJob{
+		Definition:               intervalDef("1s", time.Time{}, time.Time{}, nil),
+		AutoTriggerMissedRecords: true,
+		Actions:                  []ScheduleAction{action},
+	}
+	latest := []ScheduleActionRecord{{Action: action, ScheduledAt: now.Add(-time.Hour).UnixMilli()}}
+
+	result, err := ComputeMissedRuns(job, latest, now, MissedRunPolicyAll, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Len(t, result[0].ScheduledAt, DefaultMaxMissedRuns)
+	assert.Equal(t, now.Add(-time.Second).UnixMilli(), result[0].ScheduledAt[DefaultMaxMissedRuns-1])
+	assert.Equal(t, now.Add(-DefaultMaxMissedRuns*time.Second).UnixMilli(), result[0].ScheduledAt[0])
+}
+
+func TestComputeMissedRuns_LongGap(t *testing.T) {
+	now := utcTime(2026, time.March, 14, 10, 0, 0)
+	action := RESTAction{BaseScheduleAction: BaseScheduleAction{Id: testActionId1, Type: common.ActionREST}}
+	latestAt := now.AddDate(-1, 0, 0).Add(-500 * time.Millisecond)
+	latest := []ScheduleActionRecord{{Action: action, ScheduledAt: latestAt.UnixMilli()}}
+
+	tests := []struct {
+		name     string
+		def      ScheduleDef
+		expected []int64
+	}{
+		// the fire times are anchored at the latest run, so they are half a second off the second
+		{"interval without start timestamp", intervalDef("1s", time.Time{}, time.Time{}, nil),
+			[]int64{now.Add(-1500 * time.Millisecond).UnixMilli(), now.Add(-500 * time.Millisecond).UnixMilli()}},
+		{"interval with start timestamp", intervalDef("1s", utcTime(2020, time.January, 1, 0, 0, 0), time.Time{}, nil),
+			[]int64{now.Add(-2 * time.Second).UnixMilli(), now.Add(-time.Second).UnixMilli()}},
+		{"cron", cronDef("* * * * *", time.Time{}, time.Time{}, nil),
+			[]int64{now.Add(-2 * time.Minute).UnixMilli(), now.Add(-time.Minute).UnixMilli()}},
+		{"ended long ago", intervalDef("1s", time.Time{}, utcTime(2025, time.June, 1, 0, 0, 0), nil),
+			[]int64{utcTime(2025, time.June, 1, 0, 0, 0).Add(-1500 * time.Millisecond).UnixMilli(),
+				utcTime(2025, time.June, 1, 0, 0, 0).Add(-500 * time.Millisecond).UnixMilli()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := ScheduleJob{Definition: tt.def, AutoTriggerMissedRecords: true, Actions: []ScheduleAction{action}}
+			result, err := ComputeMissedRuns(job, latest, now, MissedRunPolicyAll, 2)
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, tt.expected, result[0].ScheduledAt)
+		})
+	}
+}
+
+func TestComputeMissedRuns_Invalid(t *testing.T) {
+	now := utcTime(2026, time.March, 14, 10, 0, 0)
+	action := RESTAction{BaseScheduleAction: BaseScheduleAction{Id: testActionId1, Type: common.ActionREST}}
+	job := ScheduleJob{
+		Definition:               cronDef("* * *", time.Time{}, time.Time{}, nil),
+		AutoTriggerMissedRecords: true,
+		Actions:                  []ScheduleAction{action},
+	}
+	latest := []ScheduleActionRecord{{Action: action, ScheduledAt: now.Add(-time.Hour).UnixMilli()}}
+
+	_, err := ComputeMissedRuns(job, latest, now, MissedRunPolicyAll, 0)
+	assert.Error(t, err, "invalid crontab")
+	_, err = ComputeMissedRuns(job, latest, now, "SOME", 0)
+	assert.Error(t, err, "unsupported policy")
+}
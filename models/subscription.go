@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2021 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -23,6 +23,37 @@ type Subscription struct {
 	ResendLimit    int
 	ResendInterval string
 	AdminState     AdminState
+	// TemplateName is the name of the NotificationTemplate which renders the notifications for the channels
+	TemplateName string
+	// Template is the legacy inline template, which is only used without the TemplateName
+	Template     *NotificationTemplate
+	GroupingKeys []string
+	// TimeZone is the optional IANA time zone in which the QuietHours are evaluated
+	TimeZone string
+	// QuietHours are the daily time windows in which the notifications are held until the windows end
+	QuietHours []DailyTimeWindow
+	// QuietHoursOverrideSeverity is the minimum Severity of the notifications delivered within the QuietHours
+	QuietHoursOverrideSeverity NotificationSeverity
+	// EscalationPolicy is the optional multi-level escalation of the unacknowledged notifications
+	EscalationPolicy *EscalationPolicy
+	// Digest batches the notifications into one digest on the interval instead of sending them one by one
+	Digest *Digest
+}
+
+// Digest batches the notifications matching a Subscription on the Interval, and lists at most MaxItems of them in
+// the digest content, or all of them if MaxItems is 0.
+type Digest struct {
+	Interval string
+	MaxItems int
+}
+
+// DailyTimeWindow is a recurring daily time range from the start time inclusive to the end time exclusive. The
+// window crosses midnight if the start time is after the end time.
+type DailyTimeWindow struct {
+	StartHour   int
+	StartMinute int
+	EndHour     int
+	EndMinute   int
 }
 
 // ChannelType controls the range of values which constitute valid delivery types for channels
@@ -41,6 +72,15 @@ func (subscription *Subscription) UnmarshalJSON(b []byte) error {
 		ResendLimit    int
 		ResendInterval string
 		AdminState     AdminState
+		TemplateName   string
+		Template       *NotificationTemplate
+		GroupingKeys   []string
+
+		TimeZone                   string
+		QuietHours                 []DailyTimeWindow
+		QuietHoursOverrideSeverity NotificationSeverity
+		EscalationPolicy           *EscalationPolicy
+		Digest                     *Digest
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal intervalAction.", err)
@@ -66,6 +106,15 @@ func (subscription *Subscription) UnmarshalJSON(b []byte) error {
 		ResendInterval: alias.ResendInterval,
 		Channels:       channels,
 		AdminState:     alias.AdminState,
+		TemplateName:   alias.TemplateName,
+		Template:       alias.Template,
+		GroupingKeys:   alias.GroupingKeys,
+
+		TimeZone:                   alias.TimeZone,
+		QuietHours:                 alias.QuietHours,
+		QuietHoursOverrideSeverity: alias.QuietHoursOverrideSeverity,
+		EscalationPolicy:           alias.EscalationPolicy,
+		Digest:                     alias.Digest,
 	}
 	return nil
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -33,6 +33,42 @@ func TestSubscription_UnmarshalJSON(t *testing.T) {
 	valid := subscriptionData()
 	jsonData, err := json.Marshal(valid)
 	require.NoError(t, err)
+	validWebhook := subscriptionData()
+	validWebhook.Channels = []Address{
+		WebhookAddress{
+			BaseAddress:     BaseAddress{Type: common.WEBHOOK, Scheme: "https", Host: "hooks.example.com", Port: 443},
+			Path:            "/services/edgex",
+			HTTPMethod:      "POST",
+			Headers:         map[string]string{"Content-Type": "application/json"},
+			BodyTemplate:    `{"text": {{ json .Content }}}`,
+			SignatureHeader: "X-Signature",
+			SecretPath:      "webhook",
+		},
+	}
+	webhookJsonData, err := json.Marshal(validWebhook)
+	require.NoError(t, err)
+	validTemplate := subscriptionData()
+	validTemplate.Template = &NotificationTemplate{
+		Name:         "alert",
+		EmailSubject: "[{{ .Severity }}] {{ .Category }}",
+		EmailBody:    "{{ .Content }}",
+	}
+	validTemplate.GroupingKeys = []string{common.GroupingKeyCategory, common.GroupingKeyDedupKey}
+	validQuietHours := subscriptionData()
+	validQuietHours.Channels = []Address{
+		RESTAddress{BaseAddress: BaseAddress{Type: common.REST, Host: "localhost", Port: 8080, MinSeverity: Critical}, HTTPMethod: "POST"},
+	}
+	validQuietHours.TimeZone = "America/New_York"
+	validQuietHours.QuietHours = []DailyTimeWindow{{StartHour: 22, EndHour: 7, EndMinute: 30}}
+	validQuietHours.QuietHoursOverrideSeverity = Critical
+	quietHoursJsonData, err := json.Marshal(validQuietHours)
+	require.NoError(t, err)
+	templateJsonData, err := json.Marshal(validTemplate)
+	require.NoError(t, err)
+	validDigest := subscriptionData()
+	validDigest.Digest = &Digest{Interval: "1h", MaxItems: 50}
+	digestJsonData, err := json.Marshal(validDigest)
+	require.NoError(t, err)
 	tests := []struct {
 		name     string
 		expected Subscription
@@ -40,6 +76,10 @@ func TestSubscription_UnmarshalJSON(t *testing.T) {
 		wantErr  bool
 	}{
 		{"valid, unmarshal Subscription", valid, jsonData, false},
+		{"valid, unmarshal Subscription with WEBHOOK address", validWebhook, webhookJsonData, false},
+		{"valid, unmarshal Subscription with template and grouping keys", validTemplate, templateJsonData, false},
+		{"valid, unmarshal Subscription with quiet hours", validQuietHours, quietHoursJsonData, false},
+		{"valid, unmarshal Subscription with digest", validDigest, digestJsonData, false},
 		{"invalid, unmarshal invalid Subscription, empty data", Subscription{}, []byte{}, true},
 		{"invalid, unmarshal invalid Subscription, string data", Subscription{}, []byte("Invalid Subscription"), true},
 	}
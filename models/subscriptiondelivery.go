@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DeliveryAction is the result of evaluating whether a notification should be delivered to a channel now
+type DeliveryAction string
+
+const (
+	// DeliveryActionDeliver delivers the notification now
+	DeliveryActionDeliver DeliveryAction = "DELIVER"
+	// DeliveryActionHold holds the notification until the end of the quiet hours
+	DeliveryActionHold DeliveryAction = "HOLD"
+	// DeliveryActionSkip doesn't deliver the notification to the channel because of its minimum severity
+	DeliveryActionSkip DeliveryAction = "SKIP"
+)
+
+// EvaluateDelivery answers whether a notification with the severity should be delivered now to the channel of the
+// subscription. The notification is skipped if its severity is lower than the MinSeverity of the channel, and held
+// within the QuietHours unless its severity reaches the QuietHoursOverrideSeverity. The returned time is the end of
+// the quiet hours, in the location of now, when the notification is held, or the zero time otherwise.
+func EvaluateDelivery(s Subscription, channel Address, severity NotificationSeverity, now time.Time) (DeliveryAction, time.Time, errors.EdgeX) {
+	if channel != nil && !severityAtLeast(severity, channel.GetBaseAddress().MinSeverity) {
+		return DeliveryActionSkip, time.Time{}, nil
+	}
+	if len(s.QuietHours) == 0 ||
+		(s.QuietHoursOverrideSeverity != "" && severityAtLeast(severity, s.QuietHoursOverrideSeverity)) {
+		return DeliveryActionDeliver, time.Time{}, nil
+	}
+
+	t := now
+	if s.TimeZone != "" {
+		zone, err := common.LoadTimeZone(s.TimeZone)
+		if err != nil {
+			return "", time.Time{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		t = now.In(zone)
+	}
+	// move to the end of the windows repeatedly as the adjacent or overlapping windows extend the quiet hours, and
+	// stop after a bounded number of passes in case the windows cover the whole day
+	for range len(s.QuietHours) + 1 {
+		moved := false
+		for _, w := range s.QuietHours {
+			if w.Contains(t) {
+				t = w.end(t)
+				moved = true
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+	if t.Equal(now) {
+		return DeliveryActionDeliver, time.Time{}, nil
+	}
+	return DeliveryActionHold, t.In(now.Location()), nil
+}
+
+// Contains checks whether the time of day of t, in the location of t, is within the window
+func (w DailyTimeWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	start := w.StartHour*60 + w.StartMinute
+	end := w.EndHour*60 + w.EndMinute
+	if start <= end {
+		return start <= minute && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// end returns the end of the window containing t in the location of t
+func (w DailyTimeWindow) end(t time.Time) time.Time {
+	day := t.Day()
+	if w.StartHour*60+w.StartMinute > w.EndHour*60+w.EndMinute && t.Hour()*60+t.Minute() >= w.StartHour*60+w.StartMinute {
+		// the window crosses midnight and ends on the next day
+		day++
+	}
+	return time.Date(t.Year(), t.Month(), day, w.EndHour, w.EndMinute, 0, 0, t.Location())
+}
+
+// severityAtLeast checks whether the severity is at least the minimum severity, and any severity satisfies the
+// empty minimum severity
+func severityAtLeast(severity, minSeverity NotificationSeverity) bool {
+	return minSeverity == "" || severityRank(severity) >= severityRank(minSeverity)
+}
+
+func severityRank(severity NotificationSeverity) int {
+	switch severity {
+	case Minor:
+		return 1
+	case Normal:
+		return 2
+	case Critical:
+		return 3
+	default:
+		return 0
+	}
+}
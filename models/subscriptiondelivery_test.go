@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateDelivery(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	email := EmailAddress{BaseAddress: BaseAddress{Type: common.EMAIL}, Recipients: []string{"test@example.com"}}
+	pager := RESTAddress{BaseAddress: BaseAddress{Type: common.REST, Host: "localhost", Port: 8080, MinSeverity: Critical}}
+	// quiet from 22:00 to 07:00 in New York, and CRITICAL notifications are delivered at any time
+	subscription := Subscription{
+		Name:                       TestSubscriptionName,
+		TimeZone:                   "America/New_York",
+		QuietHours:                 []DailyTimeWindow{{StartHour: 22, EndHour: 7}},
+		QuietHoursOverrideSeverity: Critical,
+	}
+	chained := subscription
+	chained.QuietHours = []DailyTimeWindow{{StartHour: 22, EndHour: 7}, {StartHour: 7, EndHour: 8, EndMinute: 30}}
+	noTimeZone := subscription
+	noTimeZone.TimeZone = ""
+	invalidTimeZone := subscription
+	invalidTimeZone.TimeZone = "Mars/Olympus_Mons"
+
+	tests := []struct {
+		name           string
+		subscription   Subscription
+		channel        Address
+		severity       NotificationSeverity
+		now            time.Time
+		expectedAction DeliveryAction
+		expectedUntil  time.Time
+	}{
+		{"deliver outside quiet hours", subscription, email, Minor,
+			time.Date(2026, 3, 10, 12, 0, 0, 0, newYork), DeliveryActionDeliver, time.Time{}},
+		{"hold before midnight", subscription, email, Minor,
+			time.Date(2026, 3, 10, 23, 15, 0, 0, newYork), DeliveryActionHold, time.Date(2026, 3, 11, 7, 0, 0, 0, newYork)},
+		{"hold after midnight", subscription, email, Normal,
+			time.Date(2026, 3, 11, 6, 59, 0, 0, newYork), DeliveryActionHold, time.Date(2026, 3, 11, 7, 0, 0, 0, newYork)},
+		{"deliver at the end of quiet hours", subscription, email, Minor,
+			time.Date(2026, 3, 11, 7, 0, 0, 0, newYork), DeliveryActionDeliver, time.Time{}},
+		{"deliver critical within quiet hours", subscription, email, Critical,
+			time.Date(2026, 3, 10, 23, 15, 0, 0, newYork), DeliveryActionDeliver, time.Time{}},
+		{"skip below channel minimum severity", subscription, pager, Normal,
+			time.Date(2026, 3, 10, 12, 0, 0, 0, newYork), DeliveryActionSkip, time.Time{}},
+		{"deliver at channel minimum severity", subscription, pager, Critical,
+			time.Date(2026, 3, 10, 23, 15, 0, 0, newYork), DeliveryActionDeliver, time.Time{}},
+		{"hold until the end of chained windows", chained, email, Minor,
+			time.Date(2026, 3, 10, 23, 15, 0, 0, newYork), DeliveryActionHold, time.Date(2026, 3, 11, 8, 30, 0, 0, newYork)},
+		{"evaluate in time zone and return in location of now", subscription, email, Minor,
+			time.Date(2026, 3, 11, 4, 0, 0, 0, time.UTC), DeliveryActionHold, time.Date(2026, 3, 11, 11, 0, 0, 0, time.UTC)},
+		{"evaluate in location of now without time zone", noTimeZone, email, Minor,
+			time.Date(2026, 3, 11, 4, 0, 0, 0, time.UTC), DeliveryActionHold, time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)},
+		{"hold across the DST transition", subscription, email, Minor,
+			time.Date(2026, 3, 7, 23, 0, 0, 0, newYork), DeliveryActionHold, time.Date(2026, 3, 8, 7, 0, 0, 0, newYork)},
+		{"deliver without quiet hours", Subscription{Name: TestSubscriptionName}, email, Minor,
+			time.Date(2026, 3, 10, 23, 15, 0, 0, newYork), DeliveryActionDeliver, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, until, err := EvaluateDelivery(tt.subscription, tt.channel, tt.severity, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAction, action)
+			assert.True(t, tt.expectedUntil.Equal(until), "expected %s, got %s", tt.expectedUntil, until)
+			if !until.IsZero() {
+				assert.Equal(t, tt.now.Location(), until.Location())
+			}
+		})
+	}
+
+	_, _, err = EvaluateDelivery(invalidTimeZone, email, Minor, time.Now())
+	assert.Error(t, err)
+}
+
+func TestDailyTimeWindow_Contains(t *testing.T) {
+	day := DailyTimeWindow{StartHour: 9, StartMinute: 30, EndHour: 17}
+	night := DailyTimeWindow{StartHour: 22, EndHour: 6, EndMinute: 30}
+
+	tests := []struct {
+		name     string
+		window   DailyTimeWindow
+		hour     int
+		minute   int
+		expected bool
+	}{
+		{"before day window", day, 9, 29, false},
+		{"start of day window", day, 9, 30, true},
+		{"end of day window is exclusive", day, 17, 0, false},
+		{"night window before midnight", night, 23, 59, true},
+		{"night window after midnight", night, 0, 0, true},
+		{"end of night window is exclusive", night, 6, 30, false},
+		{"outside night window", night, 12, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.window.Contains(time.Date(2026, 1, 1, tt.hour, tt.minute, 0, 0, time.UTC)))
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -11,6 +11,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 )
 
+// Transmission defines the delivery of a notification to a channel of a subscription. The OccurrenceCount is the
+// number of the notifications of the same group collapsed into the transmission, and zero means a single notification.
 type Transmission struct {
 	Created          int64
 	Id               string
@@ -20,6 +22,7 @@ type Transmission struct {
 	Records          []TransmissionRecord
 	ResendCount      int
 	Status           TransmissionStatus
+	OccurrenceCount  int
 }
 
 func (trans *Transmission) UnmarshalJSON(b []byte) error {
@@ -32,6 +35,7 @@ func (trans *Transmission) UnmarshalJSON(b []byte) error {
 		Records          []TransmissionRecord
 		ResendCount      int
 		Status           TransmissionStatus
+		OccurrenceCount  int
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal transmission.", err)
@@ -51,6 +55,7 @@ func (trans *Transmission) UnmarshalJSON(b []byte) error {
 		Records:          alias.Records,
 		ResendCount:      alias.ResendCount,
 		Status:           alias.Status,
+		OccurrenceCount:  alias.OccurrenceCount,
 	}
 	return nil
 }
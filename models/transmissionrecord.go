@@ -1,12 +1,56 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package models
 
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
 type TransmissionRecord struct {
 	Status   TransmissionStatus
 	Response string
 	Sent     int64
+	// Error is the structured error of the failed transmission attempt
+	Error *TransmissionError
+}
+
+// TransmissionError describes why a transmission attempt failed
+type TransmissionError struct {
+	// StatusCode is the status code returned by the channel, e.g. the HTTP status code of the REST channel
+	StatusCode int
+	// Kind is the EdgeX error kind, e.g. Communication or Unauthorized
+	Kind string
+	// Retryable indicates whether resending the notification may succeed
+	Retryable bool
+}
+
+// NewTransmissionError creates the TransmissionError from the EdgeX error. The error is retryable if it is a
+// communication, I/O or server side error, or if the status code indicates a timeout or rate limiting.
+func NewTransmissionError(err errors.EdgeX) *TransmissionError {
+	if err == nil {
+		return nil
+	}
+	return &TransmissionError{
+		StatusCode: err.Code(),
+		Kind:       err.Kind(),
+		Retryable:  isRetryableTransmissionError(errors.ErrKind(err.Kind()), err.Code()),
+	}
+}
+
+func isRetryableTransmissionError(kind errors.ErrKind, statusCode int) bool {
+	switch kind {
+	case errors.KindCommunicationError, errors.KindServiceUnavailable, errors.KindServerError, errors.KindIOError:
+		return true
+	}
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransmissionError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      errors.EdgeX
+		expected *TransmissionError
+	}{
+		{"communication error", errors.NewCommonEdgeX(errors.KindCommunicationError, "connection refused", nil),
+			&TransmissionError{StatusCode: http.StatusBadGateway, Kind: string(errors.KindCommunicationError), Retryable: true}},
+		{"service unavailable", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "try later", nil),
+			&TransmissionError{StatusCode: http.StatusServiceUnavailable, Kind: string(errors.KindServiceUnavailable), Retryable: true}},
+		{"unauthorized", errors.NewCommonEdgeX(errors.KindUnauthorized, "invalid credentials", nil),
+			&TransmissionError{StatusCode: http.StatusUnauthorized, Kind: string(errors.KindUnauthorized), Retryable: false}},
+		{"contract invalid", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid payload", nil),
+			&TransmissionError{StatusCode: http.StatusBadRequest, Kind: string(errors.KindContractInvalid), Retryable: false}},
+		{"no error", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewTransmissionError(tt.err))
+		})
+	}
+}
+
+func TestIsRetryableTransmissionError(t *testing.T) {
+	assert.True(t, isRetryableTransmissionError(errors.KindUnknown, http.StatusTooManyRequests))
+	assert.True(t, isRetryableTransmissionError(errors.KindUnknown, http.StatusGatewayTimeout))
+	assert.True(t, isRetryableTransmissionError(errors.KindIOError, http.StatusForbidden))
+	assert.False(t, isRetryableTransmissionError(errors.KindUnknown, http.StatusNotFound))
+}
+
+func TestTransmission_UnmarshalJSON_RecordError(t *testing.T) {
+	trans := Transmission{
+		Id:               ExampleUUID,
+		Channel:          EmailAddress{BaseAddress: BaseAddress{Type: common.EMAIL}, Recipients: []string{"test@example.com"}},
+		SubscriptionName: TestSubscriptionName,
+		Status:           Failed,
+		OccurrenceCount:  3,
+	}
+	trans.Records = []TransmissionRecord{
+		{Status: Failed, Response: "421 service not available", Sent: 1,
+			Error: &TransmissionError{StatusCode: 421, Kind: string(errors.KindServiceUnavailable), Retryable: true}},
+		{Status: Sent, Sent: 2},
+	}
+	data, err := json.Marshal(trans)
+	require.NoError(t, err)
+
+	var result Transmission
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+	assert.Equal(t, trans, result)
+}